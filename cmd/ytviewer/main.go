@@ -1,31 +1,244 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/fabean/ytviewer/internal/config"
+	"github.com/fabean/ytviewer/internal/logging"
 	"github.com/fabean/ytviewer/internal/ui"
 	"github.com/fabean/ytviewer/internal/youtube"
 )
 
 func main() {
+	daemon := flag.Bool("daemon", false, "run headless, refreshing the video cache daily at daily_refresh_time")
+	exportHistory := flag.String("export-history", "", "write the watch history to the given CSV file and exit")
+	importHistory := flag.String("import-history", "", "merge a Google Takeout watch-history.json into the watched videos and exit")
+	noAltScreen := flag.Bool("no-altscreen", false, "render inline instead of in the alternate screen, keeping the output in the terminal scrollback")
+	noColor := flag.Bool("no-color", false, "render without colors, using bold, underline and text markers instead (also enabled by NO_COLOR)")
+	importNewPipe := flag.String("import-newpipe", "", "subscribe to the YouTube channels in a NewPipe subscriptions export and exit")
+	importFreeTube := flag.String("import-freetube", "", "subscribe to the channels in a FreeTube profiles.db or subscriptions export and exit")
+	checkSubs := flag.Bool("check-subs", false, "check every subscribed channel still exists, list the dead or invalid ones and exit")
+	resetConfig := flag.Bool("reset-config", false, "back up config.json and rewrite it with the defaults, keeping the API key and subscriptions, and exit")
+	pick := flag.Bool("pick", false, "pick a video with Enter, print its URL and exit, e.g. mpv \"$(ytviewer --pick)\" (exits with status 1 if none is picked)")
+	importDays := flag.Int("import-days", 0, "with --import-history, only import videos watched in the last N days (0 imports everything)")
+	flag.Parse()
+
+	// Resetting comes first, it's the way out of a config that no longer loads
+	if *resetConfig {
+		backupPath, err := config.Reset()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		if backupPath != "" {
+			fmt.Printf("Old config backed up to %s\n", backupPath)
+		}
+		fmt.Println("Config reset to the defaults, keeping the API key and subscriptions")
+		return
+	}
+
 	// Load configuration
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		fmt.Printf("Error loading config: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
 		os.Exit(1)
 	}
 
+	// Cache subcommands work without an API key or the TUI
+	if flag.Arg(0) == "cache" {
+		if err := runCacheCommand(cfg, flag.Args()[1:]); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Exporting the watch history only needs the local stores
+	if *exportHistory != "" {
+		client, err := newClient(cfg)
+		if err != nil {
+			fmt.Printf("Error creating YouTube client: %v\n", err)
+			os.Exit(1)
+		}
+		if err := client.ExportWatchHistory(*exportHistory); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Watch history exported to %s\n", *exportHistory)
+		return
+	}
+
+	// Importing the watch history only needs the local stores
+	if *importHistory != "" {
+		client, err := newClient(cfg)
+		if err != nil {
+			fmt.Printf("Error creating YouTube client: %v\n", err)
+			os.Exit(1)
+		}
+		var since time.Time
+		if *importDays > 0 {
+			since = time.Now().AddDate(0, 0, -*importDays)
+		}
+		imported, err := client.ImportWatchHistory(*importHistory, since)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Marked %d videos as watched\n", imported)
+		return
+	}
+
 	// Check if API key is set
 	if cfg.APIKey == "YOUR_YOUTUBE_API_KEY" {
-		fmt.Println("Please set your YouTube API key in ~/.config/ytviewer/config.json")
+		fmt.Fprintln(os.Stderr, "Please set your YouTube API key in ~/.config/ytviewer/config.json")
+		os.Exit(1)
+	}
+
+	// Create YouTube client with settings from config. Messages from here on
+	// go to stderr, since with --pick stdout only carries the picked URL.
+	client, err := newClient(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating YouTube client: %v\n", err)
+		os.Exit(1)
+	}
+	// Best effort, the quota usage is only an estimate
+	defer client.SaveQuotaUsage()
+
+	// Importing subscriptions from other apps resolves channels through the API
+	if *importNewPipe != "" || *importFreeTube != "" {
+		var result youtube.ImportResult
+		if *importNewPipe != "" {
+			result, err = client.ImportNewPipe(*importNewPipe)
+		} else {
+			result, err = client.ImportFreeTube(*importFreeTube)
+		}
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Subscribed to %d channels, %d already subscribed\n", result.Added, result.Existing)
+		for _, channel := range result.Skipped {
+			fmt.Printf("Skipped %s: not found or not a supported channel URL\n", channel)
+		}
+		return
+	}
+
+	// Report subscriptions the API no longer returns a channel for
+	if *checkSubs {
+		check, err := client.CheckSubscriptions()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("%d valid, %d dead or invalid\n", len(check.Valid), len(check.Dead))
+		for _, channelID := range check.Dead {
+			fmt.Printf("Dead: %s\n", channelID)
+		}
+		if len(check.Dead) > 0 {
+			fmt.Println("Press D in the subscription manager to unsubscribe from them")
+		}
+		return
+	}
+
+	if *daemon {
+		if err := runDaemon(client, cfg); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Pick up the channels subscribed to on YouTube. Without an OAuth client
+	// the config's list is used as it is.
+	if client.OAuthConfigured() {
+		fmt.Fprintln(os.Stderr, "Syncing subscriptions from your YouTube account (the first time, the browser opens to grant access)...")
+		result, err := client.SyncSubscriptionsFromAccount()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Couldn't sync subscriptions, using the config's list: %v\n", err)
+		} else if result.Added > 0 {
+			fmt.Fprintf(os.Stderr, "Added %d channels subscribed to on YouTube\n", result.Added)
+		}
+	}
+
+	// Log to a file since the TUI owns the terminal. Logging is a debugging
+	// aid, so carry on without it if the file can't be opened.
+	if logFile, err := logging.Init(cfg.Debug); err == nil {
+		defer logFile.Close()
+	}
+
+	// Set up the look before any styles are used
+	if *pick {
+		ui.SetPickMode()
+	}
+	if cfg.ColorProfile != "" {
+		ui.SetColorProfile(cfg.ColorProfile)
+	}
+	if cfg.ASCIIMode {
+		ui.SetASCIIMode()
+	}
+	// Honor NO_COLOR (https://no-color.org) as well as the flag and config
+	if *noColor || cfg.NoColor || os.Getenv("NO_COLOR") != "" {
+		ui.SetNoColor()
+	}
+
+	// Create and start the UI with the AppModel
+	model := ui.NewAppModel(client, cfg)
+	var options []tea.ProgramOption
+	if !*noAltScreen && !cfg.NoAltScreen {
+		options = append(options, tea.WithAltScreen())
+	}
+	if cfg.Mouse {
+		options = append(options, tea.WithMouseCellMotion())
+	}
+	if *pick {
+		// Stdout is the picked URL, draw the TUI on the terminal through stderr
+		options = append(options, tea.WithOutput(os.Stderr))
+	}
+	p := tea.NewProgram(model, options...)
+	
+	finalModel, err := p.Run()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
 		os.Exit(1)
 	}
+	
+	// Remember the highlighted video so the next launch starts there
+	if app, ok := finalModel.(ui.AppModel); ok {
+		if video, ok := app.SelectedVideo(); ok {
+			last := config.LastSelected{VideoID: video.ID, PublishedAt: video.PublishedAt}
+			if err := config.Update("last_selected", last); err != nil {
+				fmt.Fprintf(os.Stderr, "Error saving the selected video: %v\n", err)
+			}
+		}
+	}
+	
+	// Keep the videos per channel adjusted during the session, if asked to
+	if maxVideos := client.MaxVideosPerChannel(); cfg.PersistMaxVideos && maxVideos != cfg.MaxVideos {
+		if err := config.Update("max_videos", maxVideos); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving max_videos: %v\n", err)
+		}
+	}
+
+	// Saved here too, exiting without a pick skips the deferred save
+	_ = client.SaveQuotaUsage()
 
-	// Create YouTube client with settings from config
+	if *pick {
+		app, _ := finalModel.(ui.AppModel)
+		video, ok := app.Picked()
+		if !ok {
+			os.Exit(1)
+		}
+		fmt.Println(client.ShareURL(video.ID))
+	}
+}
+
+// newClient creates a YouTube client configured from the config file
+func newClient(cfg *config.Config) (*youtube.Client, error) {
 	client, err := youtube.NewClient(
 		cfg.APIKey, 
 		cfg.Subscriptions, 
@@ -34,16 +247,89 @@ func main() {
 		cfg.CacheDuration,
 	)
 	if err != nil {
-		fmt.Printf("Error creating YouTube client: %v\n", err)
-		os.Exit(1)
+		return nil, err
 	}
+	client.SetSearchChannels(cfg.SearchChannels)
+	client.SetSnoozedChannels(cfg.SnoozedChannels)
+	client.SetSubscribedAt(cfg.SubscribedAt)
+	client.SetRemovedChannels(cfg.RemovedChannels)
+	client.SetBacklogDays(cfg.NewChannelBacklogDays)
+	client.SetShortURLs(cfg.ShortURLs)
+	client.SetChannelStartOffsets(cfg.ChannelStartOffset)
+	client.SetChannelResolutions(cfg.ChannelResolution)
+	client.SetSponsorBlock(cfg.SponsorBlock)
+	client.SetPlayProfile(cfg.PlayProfiles[cfg.PlayProfile])
+	client.SetThumbnailQuality(cfg.ThumbnailQuality)
+	client.SetFullscreen(cfg.MPVFullscreen)
+	client.SetGeometry(cfg.MPVGeometry)
+	client.SetWatchedRetentionDays(cfg.WatchedRetentionDays)
+	client.SetOAuthCredentials(cfg.OAuthCredentials)
+	if err := client.SetPlayer(cfg.Player); err != nil {
+		return nil, err
+	}
+	if cfg.QuotaResetAt != nil {
+		client.SetQuotaResetAt(*cfg.QuotaResetAt)
+	}
+	return client, nil
+}
 
-	// Create and start the UI with the AppModel
-	model := ui.NewAppModel(client)
-	p := tea.NewProgram(model, tea.WithAltScreen())
-	
-	if _, err := p.Run(); err != nil {
-		fmt.Printf("Error running program: %v\n", err)
-		os.Exit(1)
+// runCacheCommand handles the "ytviewer cache <dump|clear>" subcommands
+func runCacheCommand(cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: ytviewer cache <dump|clear>")
+	}
+
+	client, err := newClient(cfg)
+	if err != nil {
+		return fmt.Errorf("error creating YouTube client: %w", err)
+	}
+
+	switch args[0] {
+	case "dump":
+		return client.DumpVideoCache(os.Stdout)
+	case "clear":
+		if err := client.ClearVideoCache(); err != nil {
+			return err
+		}
+		fmt.Println("Video cache cleared")
+		return nil
+	default:
+		return fmt.Errorf("unknown cache command %q (expected dump or clear)", args[0])
+	}
+}
+
+// runDaemon refreshes the video cache every day at the configured time so
+// it is warm when the TUI is next opened
+func runDaemon(client *youtube.Client, cfg *config.Config) error {
+	if cfg.DailyRefreshTime == "" {
+		return fmt.Errorf("daily_refresh_time must be set in the config to use --daemon")
+	}
+
+	for {
+		next, err := config.NextDailyTime(cfg.DailyRefreshTime, time.Now())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Next refresh at %s\n", next.Format("Mon Jan 2 15:04"))
+		time.Sleep(time.Until(next))
+
+		// Always fetch fresh videos regardless of the cache duration
+		if err := client.ClearVideoCache(); err != nil {
+			fmt.Printf("Error clearing video cache: %v\n", err)
+		}
+		result, err := client.GetLatestVideos()
+		if err != nil {
+			fmt.Printf("Error refreshing videos: %v\n", err)
+			continue
+		}
+		if !result.QuotaExhaustedUntil.IsZero() {
+			fmt.Printf("API quota exhausted until %s, kept the cached videos\n", result.QuotaExhaustedUntil.Local().Format("Mon Jan 2 15:04"))
+			continue
+		}
+		if result.Offline {
+			fmt.Println("No network connection, kept the cached videos")
+			continue
+		}
+		fmt.Printf("Refreshed %d videos (%d channels failed)\n", len(result.Videos), len(result.Errors))
 	}
-} 
\ No newline at end of file
+}
@@ -5,22 +5,225 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// CurrentVersion is the config version of this build. It is bumped whenever
+// new features or config keys are added so upgrades can be detected.
+const CurrentVersion = 2
+
 // Config represents the application configuration
 type Config struct {
 	APIKey        string   `json:"api_key"`
 	Subscriptions []string `json:"subscriptions"` // YouTube channel IDs
 	MaxVideos     int64    `json:"max_videos"`
-	MPVOptions    struct {
-		MaxResolution  string `json:"max_resolution"`
-		HardwareAccel  bool   `json:"hardware_accel"`
-		CacheSize      string `json:"cache_size"`
-		MarkAsWatched  bool   `json:"mark_as_watched"`
-	} `json:"mpv_options"`
+	MPVOptions    MPVOptions `json:"mpv_options"`
+	Player        *Player `json:"player,omitempty"` // Media player used instead of MPV, e.g. VLC or IINA
 	CacheDuration int `json:"cache_duration"` // Cache duration in minutes
+	SearchChannels []string `json:"search_channels,omitempty"` // Channels sourced via search.list (100 quota units per fetch)
+	SnoozedChannels map[string]time.Time `json:"snoozed_channels,omitempty"` // Channel ID to time the snooze ends
+	SubscribedAt    map[string]time.Time `json:"subscribed_at,omitempty"` // Channel ID to when it was subscribed to
+	RemovedChannels []string `json:"removed_channels,omitempty"` // Channels unsubscribed from in ytviewer, which the account sync doesn't add back
+	NewChannelBacklogDays int `json:"new_channel_backlog_days,omitempty"` // Days after subscribing during which only a channel's new videos are shown, 0 shows its backlog
+	SpinnerStyle  string `json:"spinner_style"` // dot, line, jump or pulse
+	SpinnerColor  string `json:"spinner_color"` // ANSI color number or hex color
+	LoadingVideosText        string `json:"loading_videos_text"`
+	LoadingSubscriptionsText string `json:"loading_subscriptions_text"`
+	ConfigVersion int `json:"config_version"` // Version of ytviewer that last used this config
+	MarkWatched   map[string]string `json:"mark_watched"` // Play action (stream, download, browser) to "yes", "no" or "ask"
+	ConfirmMarkWatched bool `json:"confirm_mark_watched,omitempty"` // Ask whether to mark a streamed video watched once its player exits, overriding mark_watched.stream
+	DailyRefreshTime string `json:"daily_refresh_time,omitempty"` // Local time ("07:00") for a full daily refresh
+	AutoRefreshInterval string `json:"auto_refresh_interval,omitempty"` // How often ("15m") the open TUI reloads the feed, empty or "0" to disable
+	ShortURLs     bool `json:"short_urls,omitempty"` // Copy and open youtu.be/<id> URLs instead of watch?v=<id>
+	LatestOnlyChannels []string `json:"latest_only_channels,omitempty"` // Channels that only show their newest upload
+	SmartFeed          bool     `json:"smart_feed,omitempty"`           // Hide videos older than each channel's newest watched video
+	CollapseReuploads  bool     `json:"collapse_reuploads,omitempty"`   // Hide earlier uploads of videos their channel re-uploaded
+	ChannelStartOffset map[string]int `json:"channel_start_offset,omitempty"` // Channel ID to seconds to skip at the start of its videos
+	ChannelResolution map[string]string `json:"channel_resolution,omitempty"` // Channel ID to "audio", "best" or the highest video height streamed, e.g. "1080"
+	EnterNoSelection string `json:"enter_no_selection,omitempty"` // When a filter hides every video, Enter "ask"s before clearing it, "clear"s it or "ignore"s it
+	QueueAutoplayDelay int `json:"queue_autoplay_delay"` // Seconds to wait between queued videos, 0 plays the next one immediately
+	NormalizeTitles bool `json:"normalize_titles,omitempty"` // Tone down all-caps words and repeated punctuation in displayed titles
+	StripEmoji    bool `json:"strip_emoji,omitempty"` // Also remove emoji from displayed titles when normalize_titles is set
+	PlayProfiles  map[string]PlayProfile `json:"play_profiles,omitempty"` // Named presets of streaming settings, e.g. "home" and "mobile"
+	PlayProfile   string `json:"play_profile,omitempty"` // Name of the active play profile
+	MeteredConnectionWarn bool `json:"metered_connection_warn,omitempty"` // Ask which quality to stream at before playing
+	ThumbnailSize string `json:"thumbnail_size,omitempty"` // Thumbnail preview beside the list: "", "small", "medium" or "large"
+	SoftRefreshKey string `json:"soft_refresh_key,omitempty"` // Key that checks the channel feeds and only fetches channels with new uploads, "r" by default
+	HardRefreshKey string `json:"hard_refresh_key,omitempty"` // Key that clears the video cache and fetches every channel, "f" by default
+	PageSize      int `json:"page_size,omitempty"` // Videos per page of the main list, 0 fits as many as the window allows
+	HideShorts    bool `json:"hide_shorts,omitempty"` // Hide YouTube Shorts from the feed
+	OAuthCredentials // OAuth desktop client for your own account, e.g. syncing subscriptions and creating playlists
+	PersonalizedRanking bool `json:"personalized_ranking,omitempty"` // Move videos from the channels watched most lately up the newest-first feed
+	MPVFullscreen bool `json:"mpv_fullscreen,omitempty"` // Open MPV fullscreen
+	MPVGeometry   string `json:"mpv_geometry,omitempty"` // MPV window size and position in --geometry syntax, e.g. "1280x720+1920+0"
+	WatchedStyle  string `json:"watched_style,omitempty"` // How watched videos are marked: "check", "dim", "strike" or "prefix"
+	TitleOverflow string `json:"title_overflow,omitempty"` // What happens to titles too long for the list: "ellipsis" or "wrap"
+	ThumbnailQuality string `json:"thumbnail_quality,omitempty"` // Thumbnail resolution fetched: "default", "medium", "high", "standard" or "maxres"
+	ShowComments  bool `json:"show_comments,omitempty"` // Show comment counts and flag videos with an active discussion
+	NewBadgeHours int `json:"new_badge_hours,omitempty"` // How long videos that just appeared in the feed are badged NEW
+	WatchedRetentionDays int `json:"watched_retention_days,omitempty"` // Days watched videos are remembered for, 0 keeps them forever
+	SponsorBlock  bool `json:"sponsorblock,omitempty"` // Skip sponsor, intro and outro segments in mpv and downloads
+	PersistMaxVideos bool `json:"persist_max_videos,omitempty"` // Save max_videos changed with +/- when exiting
+	Categories    map[string][]string `json:"categories,omitempty"` // Category name to the channel IDs in it
+	Mouse         bool `json:"mouse,omitempty"` // Click to select and play videos, scroll with the wheel
+	NoAltScreen   bool `json:"no_altscreen,omitempty"` // Render inline so the output stays in the terminal scrollback
+	NoColor       bool `json:"no_color,omitempty"` // Render without colors, using bold, underline and text markers instead
+	ASCIIMode     bool `json:"ascii_mode,omitempty"` // Draw symbols, borders and the spinner in plain ASCII
+	ColorProfile  string `json:"color_profile,omitempty"` // Limit colors to "truecolor", "256" or "16", empty to detect
+	Debug         bool `json:"debug,omitempty"` // Write debug messages to the log file
+	QuotaResetAt  *time.Time `json:"quota_reset_at,omitempty"` // When an exhausted API quota resets, managed by ytviewer
+	LastSelected  *LastSelected `json:"last_selected,omitempty"` // Video highlighted when ytviewer last exited, managed by ytviewer
+}
+
+// ParseChannelResolution parses a channel_resolution value: "audio" for
+// audio only, "best" for no cap, or the highest video height such as "1080"
+// or "1080p". The height is 0 for audio and best.
+func ParseChannelResolution(value string) (audioOnly bool, maxHeight int, err error) {
+	switch value {
+	case "audio":
+		return true, 0, nil
+	case "best":
+		return false, 0, nil
+	}
+	maxHeight, err = strconv.Atoi(strings.TrimSuffix(value, "p"))
+	if err != nil || maxHeight <= 0 {
+		return false, 0, fmt.Errorf("invalid channel_resolution %q, expected audio, best or a height such as 1080", value)
+	}
+	return false, maxHeight, nil
+}
+
+// LastSelected remembers the highlighted video across launches. The publish
+// time finds the nearest video once the remembered one has left the feed.
+type LastSelected struct {
+	VideoID     string    `json:"video_id"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// MPVOptions are the settings for the MPV player
+type MPVOptions struct {
+	MaxResolution string `json:"max_resolution"`
+	HardwareAccel bool   `json:"hardware_accel"`
+	CacheSize     string `json:"cache_size"`
+	MarkAsWatched bool   `json:"mark_as_watched"`
 }
 
+// defaultMPVOptions returns the MPV settings a new config starts with
+func defaultMPVOptions() MPVOptions {
+	return MPVOptions{
+		MaxResolution: "1080",
+		HardwareAccel: true,
+		CacheSize:     "150M",
+		MarkAsWatched: true,
+	}
+}
+
+// fillMPVDefaults fills in the MPV settings a hand-written config leaves out,
+// so a config with just an API key and subscriptions behaves like a new one.
+// A false boolean can't be told apart from a missing one once decoded, so the
+// keys present in the file are checked instead.
+func fillMPVDefaults(config *Config, data []byte) error {
+	var present struct {
+		MPVOptions map[string]json.RawMessage `json:"mpv_options"`
+	}
+	if err := json.Unmarshal(data, &present); err != nil {
+		return err
+	}
+
+	defaults := defaultMPVOptions()
+	if config.MPVOptions.MaxResolution == "" {
+		config.MPVOptions.MaxResolution = defaults.MaxResolution
+	}
+	if config.MPVOptions.CacheSize == "" {
+		config.MPVOptions.CacheSize = defaults.CacheSize
+	}
+	if _, ok := present.MPVOptions["hardware_accel"]; !ok {
+		config.MPVOptions.HardwareAccel = defaults.HardwareAccel
+	}
+	if _, ok := present.MPVOptions["mark_as_watched"]; !ok {
+		config.MPVOptions.MarkAsWatched = defaults.MarkAsWatched
+	}
+	return nil
+}
+
+// Player is a media player videos are played with instead of MPV. The MPV
+// settings such as mpv_options and play profiles don't apply to it, only
+// through the placeholders of its arguments.
+type Player struct {
+	Command      string `json:"command,omitempty"`       // Binary found on the PATH, e.g. "vlc", empty for MPV
+	ArgsTemplate string `json:"args_template,omitempty"` // Arguments with {url}, {id}, {title}, {start} and {resolution} expanded, "{url}" if empty
+}
+
+// OAuthCredentials are the OAuth desktop client used for features that act on
+// the user's own YouTube account, which the API key can't be used for. Its
+// keys sit at the top level of the config.
+type OAuthCredentials struct {
+	ClientID     string `json:"oauth_client_id,omitempty"`
+	ClientSecret string `json:"oauth_client_secret,omitempty"`
+	TokenPath    string `json:"oauth_token_path,omitempty"` // Where the granted access is saved, ~/.config/ytviewer/token.json by default
+}
+
+// Configured reports whether an OAuth client is set
+func (o OAuthCredentials) Configured() bool {
+	return o.ClientID != "" && o.ClientSecret != ""
+}
+
+// defaultQueueAutoplayDelay is the countdown between queued videos, in seconds
+const defaultQueueAutoplayDelay = 5
+
+// fillQueueAutoplayDelay sets the default queue_autoplay_delay when the
+// config leaves it out. A delay of 0 plays the next video immediately, so
+// like mpv_options the key's presence is checked rather than the value.
+func fillQueueAutoplayDelay(config *Config, data []byte) error {
+	var present map[string]json.RawMessage
+	if err := json.Unmarshal(data, &present); err != nil {
+		return err
+	}
+	if _, ok := present["queue_autoplay_delay"]; !ok {
+		config.QueueAutoplayDelay = defaultQueueAutoplayDelay
+	}
+	return nil
+}
+
+// PlayProfile bundles the streaming settings switched together with a play profile
+type PlayProfile struct {
+	MaxResolution int    `json:"max_resolution,omitempty"` // Highest video height streamed, 0 for the default
+	AudioOnly     bool   `json:"audio_only,omitempty"`     // Stream only the audio track
+	CacheSize     string `json:"cache_size,omitempty"`     // MPV demuxer cache size ("50M"), empty for MPV's default
+}
+
+// Summary describes the profile's settings for notifications
+func (p PlayProfile) Summary() string {
+	var parts []string
+	switch {
+	case p.AudioOnly:
+		parts = append(parts, "audio only")
+	case p.MaxResolution > 0:
+		parts = append(parts, fmt.Sprintf("up to %dp", p.MaxResolution))
+	default:
+		parts = append(parts, "default resolution")
+	}
+	if p.CacheSize != "" {
+		parts = append(parts, p.CacheSize+" cache")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// defaultPlayProfiles returns the play profiles used when none are configured
+func defaultPlayProfiles() map[string]PlayProfile {
+	return map[string]PlayProfile{
+		"home":   {},
+		"mobile": {MaxResolution: 480, CacheSize: "50M"},
+	}
+}
+
+// mpvGeometryPattern matches MPV's --geometry syntax: an optional size like
+// "1280x720", "1280" or "50%", then an optional position like "+1920+0"
+var mpvGeometryPattern = regexp.MustCompile(`^(\d+%?(x\d+%?)?)?([+-]-?\d+%?[+-]-?\d+%?)?$`)
+
 // LoadConfig loads the configuration from the config file
 func LoadConfig() (*Config, error) {
 	configDir, err := getConfigDir()
@@ -28,38 +231,232 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
-	configPath := filepath.Join(configDir, "config.json")
+	configPath, format, exists := findConfigFile(configDir)
 	
 	// Check if config file exists
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+	if !exists {
 		// Create default config
 		return createDefaultConfig(configDir)
 	}
 
-	// Read config file
-	data, err := os.ReadFile(configPath)
+	// Read config file, converted to JSON if it's YAML or TOML
+	data, err := readConfigFile(configPath, format)
 	if err != nil {
-		return nil, fmt.Errorf("error reading config file: %w", err)
+		return nil, err
 	}
 
 	var config Config
 	if err := json.Unmarshal(data, &config); err != nil {
 		return nil, fmt.Errorf("error parsing config file: %w", err)
 	}
+	rememberSaved(data)
 
 	// Set default values if not specified
 	if config.MaxVideos == 0 {
 		config.MaxVideos = 10
 	}
 	
+	// Fill in whatever mpv_options leaves out, or all of it if it's missing
+	if err := fillMPVDefaults(&config, data); err != nil {
+		return nil, fmt.Errorf("error parsing config file: %w", err)
+	}
+	
+	// Count down between queued videos unless the config sets a delay
+	if err := fillQueueAutoplayDelay(&config, data); err != nil {
+		return nil, fmt.Errorf("error parsing config file: %w", err)
+	}
+	
 	// Set default cache duration to 30 minutes if not specified
 	if config.CacheDuration == 0 {
 		config.CacheDuration = 30
 	}
+	
+	// Badge new videos for a day by default
+	if config.NewBadgeHours == 0 {
+		config.NewBadgeHours = 24
+	}
+	
+	// Fill in the watched policy for any play action not specified.
+	// Streaming follows the older mpv_options.mark_as_watched setting.
+	if config.MarkWatched == nil {
+		config.MarkWatched = make(map[string]string)
+	}
+	if _, ok := config.MarkWatched["stream"]; !ok {
+		config.MarkWatched["stream"] = "no"
+		if config.MPVOptions.MarkAsWatched {
+			config.MarkWatched["stream"] = "yes"
+		}
+	}
+	for action, policy := range defaultMarkWatched() {
+		if _, ok := config.MarkWatched[action]; !ok {
+			config.MarkWatched[action] = policy
+		}
+	}
+	
+	// Offer a home and a mobile play profile unless others are configured
+	if len(config.PlayProfiles) == 0 {
+		config.PlayProfiles = defaultPlayProfiles()
+	}
+	if config.PlayProfile != "" {
+		if _, ok := config.PlayProfiles[config.PlayProfile]; !ok {
+			return nil, fmt.Errorf("play_profile %q is not one of the play_profiles", config.PlayProfile)
+		}
+	}
+	
+	// Offer to clear a filter that hides every video by default
+	switch config.EnterNoSelection {
+	case "":
+		config.EnterNoSelection = "ask"
+	case "ask", "clear", "ignore":
+	default:
+		return nil, fmt.Errorf("invalid enter_no_selection %q, expected ask, clear or ignore", config.EnterNoSelection)
+	}
+	
+	// Validate the per-channel resolutions up front
+	for _, resolution := range config.ChannelResolution {
+		if _, _, err := ParseChannelResolution(resolution); err != nil {
+			return nil, err
+		}
+	}
+	
+	// The two refreshes can't share a key, with each other or the video list
+	if config.SoftRefreshKeyOrDefault() == config.HardRefreshKeyOrDefault() {
+		return nil, fmt.Errorf("soft_refresh_key and hard_refresh_key are both %q", config.SoftRefreshKeyOrDefault())
+	}
+	if key := config.SoftRefreshKeyOrDefault(); slices.Contains(videoListKeys, key) {
+		return nil, fmt.Errorf("soft_refresh_key %q is already bound in the video list", key)
+	}
+	if key := config.HardRefreshKeyOrDefault(); slices.Contains(videoListKeys, key) {
+		return nil, fmt.Errorf("hard_refresh_key %q is already bound in the video list", key)
+	}
+	
+	// Catch typos in the geometry here rather than as an MPV error on play
+	if config.MPVGeometry != "" && !mpvGeometryPattern.MatchString(config.MPVGeometry) {
+		return nil, fmt.Errorf("invalid mpv_geometry %q, expected e.g. \"1280x720\", \"50%%\" or \"1280x720+1920+0\"", config.MPVGeometry)
+	}
+	
+	// Validate the watched style up front
+	switch config.WatchedStyle {
+	case "", "check", "dim", "strike", "prefix":
+	default:
+		return nil, fmt.Errorf("invalid watched_style %q, expected check, dim, strike or prefix", config.WatchedStyle)
+	}
+	
+	// Validate the title overflow up front
+	switch config.TitleOverflow {
+	case "", "ellipsis", "wrap":
+	default:
+		return nil, fmt.Errorf("invalid title_overflow %q, expected ellipsis or wrap", config.TitleOverflow)
+	}
+	
+	// A negative retention is a typo rather than a wish to forget everything
+	if config.WatchedRetentionDays < 0 {
+		return nil, fmt.Errorf("invalid watched_retention_days %d, expected a number of days or 0 to remember every watched video", config.WatchedRetentionDays)
+	}
+	
+	// Validate the thumbnail resolution up front
+	switch config.ThumbnailQuality {
+	case "", "default", "medium", "high", "standard", "maxres":
+	default:
+		return nil, fmt.Errorf("invalid thumbnail_quality %q, expected default, medium, high, standard or maxres", config.ThumbnailQuality)
+	}
+	
+	// Validate the thumbnail preview size up front
+	switch config.ThumbnailSize {
+	case "", "small", "medium", "large":
+	default:
+		return nil, fmt.Errorf("invalid thumbnail_size %q, expected small, medium or large", config.ThumbnailSize)
+	}
+	
+	// Validate the color profile up front
+	switch config.ColorProfile {
+	case "", "truecolor", "256", "16":
+	default:
+		return nil, fmt.Errorf("invalid color_profile %q, expected truecolor, 256 or 16", config.ColorProfile)
+	}
+	
+	// Validate the daily refresh time up front
+	if config.DailyRefreshTime != "" {
+		if _, err := NextDailyTime(config.DailyRefreshTime, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	
+	// Validate the auto-refresh interval up front
+	if _, err := ParseAutoRefresh(config.AutoRefreshInterval); err != nil {
+		return nil, err
+	}
+	
+	// Set default spinner and loading text if not specified
+	if config.SpinnerStyle == "" {
+		config.SpinnerStyle = "dot"
+	}
+	if config.SpinnerColor == "" {
+		config.SpinnerColor = "205"
+	}
+	if config.LoadingVideosText == "" {
+		config.LoadingVideosText = "Loading videos..."
+	}
+	if config.LoadingSubscriptionsText == "" {
+		config.LoadingSubscriptionsText = "Loading subscriptions..."
+	}
 
 	return &config, nil
 }
 
+// NextDailyTime returns the next occurrence after now of a "HH:MM" local time of day
+func NextDailyTime(hhmm string, now time.Time) (time.Time, error) {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid daily_refresh_time %q, expected HH:MM", hhmm)
+	}
+
+	next := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, nil
+}
+
+// minAutoRefresh is the shortest auto-refresh interval allowed, to protect the API quota
+const minAutoRefresh = time.Minute
+
+// ParseAutoRefresh parses the auto-refresh interval, returning 0 when auto-refresh is disabled
+func ParseAutoRefresh(interval string) (time.Duration, error) {
+	if interval == "" || interval == "0" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid auto_refresh_interval %q, expected a duration such as 15m", interval)
+	}
+	if d < minAutoRefresh {
+		return 0, fmt.Errorf("auto_refresh_interval %q is too short, the minimum is %s", interval, minAutoRefresh)
+	}
+	return d, nil
+}
+
+// defaultMarkWatched returns the default watched policy for each play action
+func defaultMarkWatched() map[string]string {
+	return map[string]string{
+		"stream":   "yes",
+		"download": "no",
+		"browser":  "ask",
+	}
+}
+
+// Update sets a single top-level key in the config file, leaving the rest untouched
+func Update(key string, value interface{}) error {
+	return modify(func(path string, values map[string]interface{}) error {
+		// Don't overwrite a setting that was edited in the file meanwhile
+		if editedExternally(values, key) {
+			return &ModifiedError{Path: path, Key: key}
+		}
+		values[key] = value
+		return nil
+	})
+}
+
 // getConfigDir returns the configuration directory path
 func getConfigDir() (string, error) {
 	homeDir, err := os.UserHomeDir()
@@ -79,23 +476,7 @@ func getConfigDir() (string, error) {
 
 // createDefaultConfig creates a default configuration file
 func createDefaultConfig(configDir string) (*Config, error) {
-	config := &Config{
-		APIKey:        "YOUR_YOUTUBE_API_KEY",
-		Subscriptions: []string{},
-		MaxVideos:     10,
-		MPVOptions: struct {
-			MaxResolution  string `json:"max_resolution"`
-			HardwareAccel  bool   `json:"hardware_accel"`
-			CacheSize      string `json:"cache_size"`
-			MarkAsWatched  bool   `json:"mark_as_watched"`
-		}{
-			MaxResolution:  "1080",
-			HardwareAccel:  true,
-			CacheSize:      "150M",
-			MarkAsWatched:  true,
-		},
-		CacheDuration: 30,
-	}
+	config := defaultConfig()
 
 	// Create config file
 	configPath := filepath.Join(configDir, "config.json")
@@ -107,7 +488,52 @@ func createDefaultConfig(configDir string) (*Config, error) {
 	if err := os.WriteFile(configPath, data, 0644); err != nil {
 		return nil, fmt.Errorf("error writing default config: %w", err)
 	}
+	rememberSaved(data)
 
-	fmt.Printf("Created default config at %s. Please edit it to add your YouTube API key.\n", configPath)
+	// Stderr, stdout is reserved for the picked URL with --pick
+	fmt.Fprintf(os.Stderr, "Created default config at %s. Please edit it to add your YouTube API key.\n", configPath)
 	return config, nil
-} 
\ No newline at end of file
+}
+
+// defaultConfig returns the configuration a fresh install starts with
+func defaultConfig() *Config {
+	return &Config{
+		APIKey:        "YOUR_YOUTUBE_API_KEY",
+		Subscriptions: []string{},
+		MaxVideos:     10,
+		MPVOptions:    defaultMPVOptions(),
+		CacheDuration: 30,
+		SpinnerStyle:  "dot",
+		SpinnerColor:  "205",
+		LoadingVideosText:        "Loading videos...",
+		LoadingSubscriptionsText: "Loading subscriptions...",
+		ConfigVersion: CurrentVersion,
+		MarkWatched:   defaultMarkWatched(),
+		QueueAutoplayDelay: defaultQueueAutoplayDelay,
+		EnterNoSelection: "ask",
+	}
+}
+
+// videoListKeys are the keys the video list binds to other actions, which
+// the refresh keys can't take
+var videoListKeys = []string{
+	"q", "ctrl+c", "enter", "esc", "/", "?", "up", "down", "j", "k",
+	"s", "L", "b", "W", "G", "S", "T", "H", "X", "Z", "*", ".", ",", "F", "I", "V", "M", "!", "K", "e",
+	"N", "+", "-", "C", "o", "t", "i", "J", "U", "c", "Y", "E", "O", "D", "p", "R", "a", "P", "x", "w",
+}
+
+// SoftRefreshKeyOrDefault returns the key bound to the soft refresh, "r" unless configured
+func (c *Config) SoftRefreshKeyOrDefault() string {
+	if c.SoftRefreshKey == "" {
+		return "r"
+	}
+	return c.SoftRefreshKey
+}
+
+// HardRefreshKeyOrDefault returns the key bound to the hard refresh, "f" unless configured
+func (c *Config) HardRefreshKeyOrDefault() string {
+	if c.HardRefreshKey == "" {
+		return "f"
+	}
+	return c.HardRefreshKey
+}
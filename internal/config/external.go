@@ -0,0 +1,108 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sync"
+)
+
+// saved is the config file's contents as ytviewer last read or wrote it, so
+// edits made to the file in an editor while ytviewer runs can be told apart
+// from ytviewer's own changes
+var saved struct {
+	sync.Mutex
+	values map[string]interface{}
+}
+
+// ModifiedError reports that a setting was changed by editing the config file
+// while ytviewer was running, and wasn't overwritten
+type ModifiedError struct {
+	Path string
+	Key  string
+}
+
+func (e *ModifiedError) Error() string {
+	return fmt.Sprintf("%s was edited outside ytviewer since it was loaded, so %s wasn't saved to keep your edit. Restart ytviewer to pick up the edited config", filepath.Base(e.Path), e.Key)
+}
+
+// rememberSaved records the JSON config as the file's known contents
+func rememberSaved(jsonData []byte) {
+	var values map[string]interface{}
+	if err := json.Unmarshal(jsonData, &values); err != nil {
+		return
+	}
+	saved.values = values
+}
+
+// editedExternally reports whether the key's value in the file differs from
+// the one ytviewer last read or wrote
+func editedExternally(current map[string]interface{}, key string) bool {
+	if saved.values == nil {
+		return false
+	}
+	return !reflect.DeepEqual(current[key], saved.values[key])
+}
+
+// modify reads the config file, lets change update its values and writes it
+// back in the format it was read in. Other keys are kept as they are in the
+// file, including ones edited while ytviewer runs.
+func modify(change func(path string, values map[string]interface{}) error) error {
+	saved.Lock()
+	defer saved.Unlock()
+
+	configDir, err := getConfigDir()
+	if err != nil {
+		return err
+	}
+	configPath, format, _ := findConfigFile(configDir)
+
+	data, err := readConfigFile(configPath, format)
+	if err != nil {
+		return err
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("error parsing config file: %w", err)
+	}
+
+	if err := change(configPath, values); err != nil {
+		return err
+	}
+
+	updatedData, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("error creating updated config: %w", err)
+	}
+	if err := writeConfigFile(configPath, format, updatedData); err != nil {
+		return err
+	}
+	rememberSaved(updatedData)
+	return nil
+}
+
+// UpdateList changes a top-level list of strings in the config file, starting
+// from the list in the file rather than the one loaded at startup, so entries
+// added or removed by editing the file in the meantime are kept. It returns
+// the list as saved.
+func UpdateList(key string, change func(list []string) []string) ([]string, error) {
+	var list []string
+	err := modify(func(path string, values map[string]interface{}) error {
+		list = nil
+		if raw, ok := values[key].([]interface{}); ok {
+			for _, item := range raw {
+				if s, ok := item.(string); ok {
+					list = append(list, s)
+				}
+			}
+		}
+		list = change(list)
+		values[key] = list
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
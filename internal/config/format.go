@@ -0,0 +1,156 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// configFormat reads and writes the config file in one of the supported
+// formats. The config is handled as JSON everywhere else, so each format
+// converts from and to JSON.
+type configFormat struct {
+	name   string
+	toJSON func(data []byte) ([]byte, error)
+	encode func(jsonData []byte) ([]byte, error)
+}
+
+// configFormats are the supported formats by file extension, in the order
+// they're looked for. JSON comes first and is used for new configs.
+var configFormats = []struct {
+	ext    string
+	format configFormat
+}{
+	{".json", configFormat{name: "JSON", toJSON: jsonAsIs, encode: indentJSON}},
+	{".yaml", configFormat{name: "YAML", toJSON: yamlToJSON, encode: jsonToYAML}},
+	{".yml", configFormat{name: "YAML", toJSON: yamlToJSON, encode: jsonToYAML}},
+	{".toml", configFormat{name: "TOML", toJSON: tomlToJSON, encode: jsonToTOML}},
+}
+
+// findConfigFile returns the path and format of the config file in the
+// config directory. When there is none yet, it returns where a new JSON
+// config goes and exists is false.
+func findConfigFile(configDir string) (path string, format configFormat, exists bool) {
+	for _, candidate := range configFormats {
+		path := filepath.Join(configDir, "config"+candidate.ext)
+		if _, err := os.Stat(path); err == nil {
+			return path, candidate.format, true
+		}
+	}
+	return filepath.Join(configDir, "config.json"), configFormats[0].format, false
+}
+
+// readConfigFile reads the config file and returns its contents as JSON
+func readConfigFile(path string, format configFormat) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %w", err)
+	}
+	jsonData, err := format.toJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s config file: %w", format.name, err)
+	}
+	return jsonData, nil
+}
+
+// writeConfigFile writes the JSON config to the config file in its format
+func writeConfigFile(path string, format configFormat, jsonData []byte) error {
+	data, err := format.encode(jsonData)
+	if err != nil {
+		return fmt.Errorf("error creating %s config: %w", format.name, err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		if isReadOnly(err) {
+			return &ReadOnlyError{Path: path, Err: err}
+		}
+		return fmt.Errorf("error writing config file: %w", err)
+	}
+	return nil
+}
+
+// jsonAsIs reads a JSON config, which needs no conversion
+func jsonAsIs(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+// indentJSON indents the JSON the way config files are written
+func indentJSON(data []byte) ([]byte, error) {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, data, "", "  "); err != nil {
+		return nil, err
+	}
+	return indented.Bytes(), nil
+}
+
+// yamlToJSON converts a YAML config to JSON
+func yamlToJSON(data []byte) ([]byte, error) {
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	return json.Marshal(config)
+}
+
+// jsonToYAML converts a JSON config to YAML
+func jsonToYAML(jsonData []byte) ([]byte, error) {
+	var config map[string]interface{}
+	if err := json.Unmarshal(jsonData, &config); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(config)
+}
+
+// tomlToJSON converts a TOML config to JSON
+func tomlToJSON(data []byte) ([]byte, error) {
+	var config map[string]interface{}
+	if err := toml.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	return json.Marshal(config)
+}
+
+// jsonToTOML converts a JSON config to TOML. Numbers are kept as written so
+// whole numbers don't turn into floats, and nulls are dropped since TOML
+// has no null.
+func jsonToTOML(jsonData []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	var config map[string]interface{}
+	if err := decoder.Decode(&config); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(withoutNulls(config)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// withoutNulls removes null values from the decoded JSON, recursively
+func withoutNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if item == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = withoutNulls(item)
+		}
+	case []interface{}:
+		kept := v[:0]
+		for _, item := range v {
+			if item != nil {
+				kept = append(kept, withoutNulls(item))
+			}
+		}
+		return kept
+	}
+	return value
+}
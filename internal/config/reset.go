@@ -0,0 +1,63 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Reset rewrites the config file with the defaults, in the format it's
+// written in, keeping the API key and subscriptions from the existing file
+// when it can still be parsed. The old file is copied next to it first and
+// the backup's path is returned, empty when there was no config file to back up.
+func Reset() (string, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return "", err
+	}
+	configPath, format, exists := findConfigFile(configDir)
+	config := defaultConfig()
+
+	backupPath := ""
+	if exists {
+		data, err := os.ReadFile(configPath)
+		if err != nil {
+			return "", fmt.Errorf("error reading config file: %w", err)
+		}
+		backupPath = fmt.Sprintf("%s.%s.bak", configPath, time.Now().Format("20060102-150405"))
+		if err := os.WriteFile(backupPath, data, 0644); err != nil {
+			return "", fmt.Errorf("error backing up config: %w", err)
+		}
+		// A file that no longer parses keeps the defaults
+		if jsonData, err := format.toJSON(data); err == nil {
+			preserveAccount(config, jsonData)
+		}
+	}
+
+	updatedData, err := json.Marshal(config)
+	if err != nil {
+		return backupPath, fmt.Errorf("error creating default config: %w", err)
+	}
+	return backupPath, writeConfigFile(configPath, format, updatedData)
+}
+
+// preserveAccount copies the API key and subscriptions from the old config
+// file into the new config. Each is decoded on its own so one malformed
+// field doesn't lose the other, and a file that isn't JSON at all keeps the
+// defaults.
+func preserveAccount(config *Config, data []byte) {
+	var old map[string]json.RawMessage
+	if err := json.Unmarshal(data, &old); err != nil {
+		return
+	}
+
+	var apiKey string
+	if err := json.Unmarshal(old["api_key"], &apiKey); err == nil && apiKey != "" {
+		config.APIKey = apiKey
+	}
+	var subscriptions []string
+	if err := json.Unmarshal(old["subscriptions"], &subscriptions); err == nil && subscriptions != nil {
+		config.Subscriptions = subscriptions
+	}
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"syscall"
+)
+
+// ReadOnlyError reports that the config file can't be written, e.g. because
+// of its permissions or because it's a symlink into a read-only dotfiles store
+type ReadOnlyError struct {
+	Path string
+	Err  error
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("config file %s is read-only, so changes can't be saved. Fix its permissions, or edit the file it's managed from if it's linked in from your dotfiles (%v)", e.Path, e.Err)
+}
+
+func (e *ReadOnlyError) Unwrap() error {
+	return e.Err
+}
+
+// CheckWritable returns a ReadOnlyError when the config file can't be
+// written, so changes can be refused before they're made in memory
+func CheckWritable() error {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return err
+	}
+	configPath, _, _ := findConfigFile(configDir)
+
+	// Opening for writing without truncating checks the permissions of the
+	// file, or of the file a symlink points to, without changing it
+	file, err := os.OpenFile(configPath, os.O_WRONLY, 0)
+	if err != nil {
+		if isReadOnly(err) {
+			return &ReadOnlyError{Path: configPath, Err: err}
+		}
+		return fmt.Errorf("error opening config file: %w", err)
+	}
+	return file.Close()
+}
+
+// isReadOnly reports whether a write failed because of permissions or a
+// read-only file system
+func isReadOnly(err error) bool {
+	return errors.Is(err, fs.ErrPermission) || errors.Is(err, syscall.EROFS)
+}
@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxLogSize is the size at which the log file is rotated on startup
+const maxLogSize = 1 << 20
+
+// Path returns the path to the log file
+func Path() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "ytviewer.log"), nil
+}
+
+// Init opens the log file and makes it the destination of the default slog
+// logger. Logs over maxLogSize are moved aside to ytviewer.log.old first.
+// Debug messages are only written when debug is set.
+func Init(debug bool) (io.Closer, error) {
+	logPath, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return nil, fmt.Errorf("error creating log directory: %w", err)
+	}
+
+	if info, err := os.Stat(logPath); err == nil && info.Size() > maxLogSize {
+		_ = os.Rename(logPath, logPath+".old")
+	}
+
+	file, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening log file: %w", err)
+	}
+
+	level := slog.LevelInfo
+	if debug {
+		level = slog.LevelDebug
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(file, &slog.HandlerOptions{Level: level})))
+	return file, nil
+}
+
+// Tail returns up to the last n lines of the log file
+func Tail(n int) ([]string, error) {
+	logPath, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(logPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading log file: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return nil, nil
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines, nil
+}
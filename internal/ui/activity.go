@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// activityStyle colors the download and queue indicators in the list
+var activityStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#5FAFFF"))
+
+// itemActivity is what's happening to a video in the background, shown next
+// to its title until it's done
+type itemActivity struct {
+	downloading bool    // Being downloaded with yt-dlp
+	percent     float64 // How much of the download is done
+	queued      int     // Position in the play queue, 0 when not queued
+}
+
+// indicator renders the activity for the list, empty when there is none
+func (a itemActivity) indicator() string {
+	indicator := ""
+	if a.downloading {
+		percent := fmt.Sprintf("%.0f%%", a.percent)
+		if plainMarkers {
+			indicator += " [downloading " + percent + "]"
+		} else {
+			indicator += " " + activityStyle.Render(glyph("⬇")+" "+percent)
+		}
+	}
+	if a.queued > 0 {
+		position := fmt.Sprintf("#%d", a.queued)
+		if plainMarkers {
+			indicator += " [queued " + position + "]"
+		} else {
+			indicator += " " + activityStyle.Render(position)
+		}
+	}
+	return indicator
+}
+
+// downloadProgressMsg reports how far a download has got. More updates
+// follow on the channel until it's closed.
+type downloadProgressMsg struct {
+	videoID string
+	percent float64
+	updates <-chan float64
+}
+
+// downloadDoneMsg is sent when a download finishes or fails
+type downloadDoneMsg struct {
+	video youtube.Video
+	err   error
+}
+
+// activity returns what's happening to the video in the background
+func (m Model) activity(videoID string) itemActivity {
+	var activity itemActivity
+	if percent, ok := m.downloads[videoID]; ok {
+		activity.downloading = true
+		activity.percent = percent
+	}
+	for i, queued := range m.queue {
+		if queued.ID == videoID {
+			activity.queued = i + 1
+			break
+		}
+	}
+	return activity
+}
+
+// refreshActivity updates the indicators of the videos' list items
+func (m *Model) refreshActivity(videoIDs ...string) {
+	for _, videoID := range videoIDs {
+		i, ok := m.itemIndex[videoID]
+		if !ok || i >= len(m.list.Items()) {
+			continue
+		}
+		if videoItem, ok := m.list.Items()[i].(Item); ok && videoItem.video.ID == videoID {
+			videoItem.activity = m.activity(videoID)
+			m.list.SetItem(i, videoItem)
+		}
+	}
+}
+
+// refreshQueueActivity updates the queue positions of the given videos and
+// of every queued video, since positions shift as the queue moves
+func (m *Model) refreshQueueActivity(videoIDs ...string) {
+	for _, queued := range m.queue {
+		videoIDs = append(videoIDs, queued.ID)
+	}
+	m.refreshActivity(videoIDs...)
+}
+
+// startDownload downloads the video in the background, reporting progress
+// in its list item
+func (m Model) startDownload(video youtube.Video) (Model, tea.Cmd) {
+	if _, ok := m.downloads[video.ID]; ok {
+		return m.notify("Already downloading")
+	}
+	m.downloads[video.ID] = 0
+	m.refreshActivity(video.ID)
+
+	updates := make(chan float64, 1)
+	download := func() tea.Msg {
+		err := m.youtubeClient.DownloadVideo(video.ID, func(percent float64) {
+			// Drop updates the UI hasn't caught up with, a newer one follows
+			select {
+			case updates <- percent:
+			default:
+			}
+		})
+		close(updates)
+		return downloadDoneMsg{video: video, err: err}
+	}
+	return m, tea.Batch(download, waitForDownloadProgress(video.ID, updates))
+}
+
+// waitForDownloadProgress waits for the next progress update of a download
+func waitForDownloadProgress(videoID string, updates <-chan float64) tea.Cmd {
+	return func() tea.Msg {
+		percent, ok := <-updates
+		if !ok {
+			return nil
+		}
+		return downloadProgressMsg{videoID: videoID, percent: percent, updates: updates}
+	}
+}
+
+// applyDownloadProgress shows the download's progress and waits for the next update
+func (m Model) applyDownloadProgress(msg downloadProgressMsg) (Model, tea.Cmd) {
+	if _, ok := m.downloads[msg.videoID]; !ok {
+		return m, nil
+	}
+	m.downloads[msg.videoID] = msg.percent
+	m.refreshActivity(msg.videoID)
+	return m, waitForDownloadProgress(msg.videoID, msg.updates)
+}
+
+// finishDownload clears the download indicator and reports the result
+func (m Model) finishDownload(msg downloadDoneMsg) (tea.Model, tea.Cmd) {
+	delete(m.downloads, msg.video.ID)
+	m.refreshActivity(msg.video.ID)
+	if msg.err != nil {
+		return m.Update(errMsg{msg.err})
+	}
+	return m.Update(playedMsg{
+		action:  actionDownload,
+		video:   msg.video,
+		message: "Video downloaded successfully",
+	})
+}
@@ -1,31 +1,74 @@
 package ui
 
 import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/bubbletea"
+	"github.com/fabean/ytviewer/internal/config"
 	"github.com/fabean/ytviewer/internal/youtube"
 )
 
 // AppModel is the parent model that manages switching between views
 type AppModel struct {
 	youtubeClient *youtube.Client
-	currentView   string
+	cfg           *config.Config
+	views         []string // Open views, the one on screen last, for going back with b
 	videoModel    Model
 	subModel      SubscriptionModel
+	whatsNew      []changelogEntry // Unseen changelog entries, shown until dismissed
+	logView       viewport.Model
+	width         int
+	height        int
 }
 
 // NewAppModel creates a new app model
-func NewAppModel(client *youtube.Client) AppModel {
+func NewAppModel(client *youtube.Client, cfg *config.Config) AppModel {
 	return AppModel{
 		youtubeClient: client,
-		currentView:   "videos", // Start with video list
-		videoModel:    NewModel(client),
-		subModel:      NewSubscriptionModel(client),
+		cfg:           cfg,
+		views:         []string{viewVideos}, // Start with video list
+		videoModel:    NewModel(client, cfg),
+		subModel:      NewSubscriptionModel(client, cfg),
+		whatsNew:      changesSince(cfg.ConfigVersion),
 	}
 }
 
 // Init initializes the app model
 func (m AppModel) Init() tea.Cmd {
-	return m.videoModel.Init()
+	return tea.Batch(
+		m.videoModel.Init(),
+		m.videoModel.startupWarning(),
+		m.scheduleDailyRefresh(),
+		m.scheduleAutoRefresh(),
+		waitForPlayerExit(m.youtubeClient),
+	)
+}
+
+// scheduleAutoRefresh schedules the next periodic reload of the feed
+func (m AppModel) scheduleAutoRefresh() tea.Cmd {
+	interval, err := config.ParseAutoRefresh(m.cfg.AutoRefreshInterval)
+	if err != nil || interval == 0 {
+		return nil
+	}
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return autoRefreshMsg{}
+	})
+}
+
+// scheduleDailyRefresh schedules the next full refresh at the configured time of day
+func (m AppModel) scheduleDailyRefresh() tea.Cmd {
+	if m.cfg.DailyRefreshTime == "" {
+		return nil
+	}
+	now := m.videoModel.clock.Now()
+	next, err := config.NextDailyTime(m.cfg.DailyRefreshTime, now)
+	if err != nil {
+		return nil
+	}
+	return tea.Tick(next.Sub(now), func(time.Time) tea.Msg {
+		return dailyRefreshMsg{}
+	})
 }
 
 // Update handles app model updates
@@ -33,20 +76,75 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.logView.Width = msg.Width
+		m.logView.Height = logViewportHeight(msg.Height)
+
+	case dailyRefreshMsg:
+		// Clear the cache so the next load fetches fresh videos regardless
+		// of the cache duration, and schedule tomorrow's refresh
+		_ = m.youtubeClient.ClearVideoCache()
+		cmds = append(cmds, m.scheduleDailyRefresh())
+		if m.modelView() != viewVideos {
+			return m, tea.Batch(cmds...)
+		}
+
+	case playerExitedMsg:
+		// Keep listening for the next player to exit
+		cmds = append(cmds, waitForPlayerExit(m.youtubeClient))
+		// The video list tracks the players and the queue, so it hears about
+		// the exit whichever view is showing
+		if m.modelView() != viewVideos {
+			videoModel, cmd := m.videoModel.Update(msg)
+			if vm, ok := videoModel.(Model); ok {
+				m.videoModel = vm
+				cmds = append(cmds, cmd)
+			}
+			return m, tea.Batch(cmds...)
+		}
+
+	case autoRefreshMsg:
+		// Only reload while the feed is on screen, and keep the schedule going
+		cmds = append(cmds, m.scheduleAutoRefresh())
+		if m.modelView() != viewVideos {
+			return m, tea.Batch(cmds...)
+		}
+
 	case tea.KeyMsg:
-		if m.currentView == "videos" && msg.String() == "s" {
-			// Switch to subscription view
-			m.currentView = "subscriptions"
-			return m, m.subModel.Init()
-		} else if m.currentView == "subscriptions" && msg.String() == "b" {
-			// Switch back to video view
-			m.currentView = "videos"
-			return m, m.videoModel.Init()
+		// Any key dismisses the "What's new" modal and records the version as seen
+		if len(m.whatsNew) > 0 {
+			m.whatsNew = nil
+			return m, func() tea.Msg {
+				// Best effort, at worst the modal is shown again next launch
+				_ = config.Update("config_version", config.CurrentVersion)
+				return nil
+			}
+		}
+
+		// While the log view is open, keys scroll the log
+		if m.currentView() == viewLogs {
+			return m.updateLogs(msg)
+		}
+		
+		if !m.capturingInput() {
+			switch {
+			case msg.String() == "L":
+				// Open the log view from either view
+				return m.pushView(viewLogs)
+			case msg.String() == "s" && m.currentView() == viewVideos:
+				// Switch to subscription view
+				return m.pushView(viewSubscriptions)
+			case msg.String() == "b" && len(m.views) > 1:
+				// Go back to the previous view, however deep
+				return m.popView()
+			}
 		}
 	}
 
-	// Update the current view
-	if m.currentView == "videos" {
+	// Update the view below any log view
+	if m.modelView() == viewVideos {
 		var cmd tea.Cmd
 		videoModel, cmd := m.videoModel.Update(msg)
 		if vm, ok := videoModel.(Model); ok {
@@ -67,7 +165,22 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // View renders the current view
 func (m AppModel) View() string {
-	if m.currentView == "videos" {
+	view := m.view()
+	if plainMarkers {
+		return stripColors(view)
+	}
+	return view
+}
+
+// view renders the active screen
+func (m AppModel) view() string {
+	if len(m.whatsNew) > 0 {
+		return whatsNewView(m.whatsNew, m.width, m.height)
+	}
+	switch m.currentView() {
+	case viewLogs:
+		return m.logsView()
+	case viewVideos:
 		return m.videoModel.View()
 	}
 	return m.subModel.View()
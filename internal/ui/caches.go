@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openCaches shows the cache maintenance panel
+func (m Model) openCaches() (Model, tea.Cmd) {
+	m.cacheStatus = m.youtubeClient.GetCacheStatus()
+	m.showCaches = true
+	return m, nil
+}
+
+// updateCaches handles keys while the cache maintenance panel is open
+func (m Model) updateCaches(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cleared string
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "K", "esc":
+		m.showCaches = false
+		return m, nil
+	case "1":
+		if err := m.youtubeClient.ClearVideoCache(); err != nil {
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		cleared = "video cache"
+	case "2":
+		m.youtubeClient.ClearSubscriptionCache()
+		cleared = "subscription cache"
+	case "3":
+		m.youtubeClient.ClearChannelNameCache()
+		cleared = "channel name cache"
+	case "4":
+		m.clearThumbnails()
+		cleared = "thumbnail cache"
+	case "a":
+		if err := m.youtubeClient.ClearVideoCache(); err != nil {
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		m.youtubeClient.ClearSubscriptionCache()
+		m.youtubeClient.ClearChannelNameCache()
+		m.clearThumbnails()
+		cleared = "all caches"
+	default:
+		return m, nil
+	}
+
+	m.cacheStatus = m.youtubeClient.GetCacheStatus()
+	return m.notify("Cleared " + cleared)
+}
+
+// clearThumbnails forgets every downloaded thumbnail
+func (m *Model) clearThumbnails() {
+	m.thumbnails = newThumbnailCache()
+}
+
+// cachesView renders the cache maintenance panel
+func (m Model) cachesView() string {
+	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Width(4)
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(18)
+	valueStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#25A065"))
+	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	s := m.cacheStatus
+	videos := "empty"
+	if s.VideoChannels > 0 {
+		videos = fmt.Sprintf("%s video%s from %d channel%s",
+			formatNumber(uint64(s.Videos)), pluralize(s.Videos), s.VideoChannels, pluralize(s.VideoChannels))
+		if s.VideoFileSize > 0 {
+			videos += ", " + formatBytes(s.VideoFileSize)
+		}
+		if !s.VideoFetchedAt.IsZero() {
+			videos += ", fetched " + formatTimeAgo(s.VideoFetchedAt, m.clock.Now())
+		}
+	}
+
+	rows := []struct {
+		key   string
+		label string
+		value string
+	}{
+		{"1", "Videos", videos},
+		{"2", "Subscriptions", countOrEmpty(s.Subscriptions, "channel")},
+		{"3", "Channel names", countOrEmpty(s.ChannelNames, "name")},
+		{"4", "Thumbnails", countOrEmpty(m.thumbnails.len(), "thumbnail")},
+	}
+
+	var sb strings.Builder
+	sb.WriteString(lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("Caches"))
+	sb.WriteString("\n\n")
+	for _, row := range rows {
+		sb.WriteString(keyStyle.Render(row.key) + labelStyle.Render(row.label) + valueStyle.Render(row.value) + "\n")
+	}
+	sb.WriteString("\n")
+	sb.WriteString(hintStyle.Render(helpText("1-4: clear a cache", "a: clear all", "K/Esc: close")))
+	sb.WriteString("\n")
+	sb.WriteString(hintStyle.Render("Cleared caches are filled again on the next refresh (r)"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		lipgloss.NewStyle().
+			BorderStyle(panelBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(1, 2).
+			Render(sb.String()),
+	)
+}
+
+// countOrEmpty describes how many entries a cache holds
+func countOrEmpty(n int, noun string) string {
+	if n == 0 {
+		return "empty"
+	}
+	return fmt.Sprintf("%s %s%s", formatNumber(uint64(n)), noun, pluralize(n))
+}
+
+// formatBytes formats a size in bytes as B, KB, MB or GB
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<30:
+		return fmt.Sprintf("%.2f GB", float64(n)/(1<<30))
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
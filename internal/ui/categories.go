@@ -0,0 +1,205 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/config"
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// uncategorized is the folder for channels that aren't in any category
+const uncategorized = "Uncategorized"
+
+// subscriptionRow is a line of the subscription manager: either a category
+// header (in the folder view) or a channel
+type subscriptionRow struct {
+	category string // Category the row belongs to, empty in the flat view
+	header   bool
+	sub      youtube.Subscription
+}
+
+// sortedCategories returns the configured category names in alphabetical order
+func sortedCategories(categories map[string][]string) []string {
+	names := make([]string, 0, len(categories))
+	for name := range categories {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+	return names
+}
+
+// rows returns the lines the cursor moves over: every subscription in the
+// flat view, or channels grouped under collapsible category headers
+func (m SubscriptionModel) rows() []subscriptionRow {
+	if !m.folderView {
+		rows := make([]subscriptionRow, len(m.subscriptions))
+		for i, sub := range m.subscriptions {
+			rows[i] = subscriptionRow{sub: sub}
+		}
+		return rows
+	}
+
+	categorized := make(map[string]bool)
+	var rows []subscriptionRow
+	addGroup := func(category string, members map[string]bool) {
+		rows = append(rows, subscriptionRow{category: category, header: true})
+		if m.collapsed[category] {
+			return
+		}
+		for _, sub := range m.subscriptions {
+			if members[sub.ID] {
+				rows = append(rows, subscriptionRow{category: category, sub: sub})
+			}
+		}
+	}
+
+	for _, category := range sortedCategories(m.cfg.Categories) {
+		members := make(map[string]bool)
+		for _, id := range m.cfg.Categories[category] {
+			members[id] = true
+			categorized[id] = true
+		}
+		addGroup(category, members)
+	}
+
+	rest := make(map[string]bool)
+	for _, sub := range m.subscriptions {
+		if !categorized[sub.ID] {
+			rest[sub.ID] = true
+		}
+	}
+	if len(rest) > 0 {
+		addGroup(uncategorized, rest)
+	}
+	return rows
+}
+
+// selected returns the channel under the cursor, if the cursor is on a channel
+func (m SubscriptionModel) selected() (youtube.Subscription, bool) {
+	rows := m.rows()
+	if m.cursor < 0 || m.cursor >= len(rows) || rows[m.cursor].header {
+		return youtube.Subscription{}, false
+	}
+	return rows[m.cursor].sub, true
+}
+
+// clampCursor keeps the cursor within the rows after they change
+func (m *SubscriptionModel) clampCursor() {
+	if rows := len(m.rows()); m.cursor >= rows {
+		m.cursor = rows - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+// newCategoryInput creates the text input for naming a channel's category
+func newCategoryInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = "Category, e.g. Tech (empty to uncategorize)"
+	ti.CharLimit = 40
+	ti.Width = 40
+	return ti
+}
+
+// updateCategory handles keys while assigning the selected channel to a category
+func (m SubscriptionModel) updateCategory(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.categoryMode = false
+		return m, nil
+
+	case "enter":
+		m.categoryMode = false
+		sub, ok := m.selected()
+		if !ok {
+			return m, nil
+		}
+		m.cfg.Categories = assignCategory(m.cfg.Categories, sub.ID, strings.TrimSpace(m.categoryInput.Value()))
+		m.clampCursor()
+		categories := m.cfg.Categories
+		return m, func() tea.Msg {
+			if err := config.Update("categories", categories); err != nil {
+				return errMsg{err}
+			}
+			return nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.categoryInput, cmd = m.categoryInput.Update(msg)
+	return m, cmd
+}
+
+// assignCategory moves a channel into a single category, or out of all
+// categories when the name is empty. Empty categories are removed.
+func assignCategory(categories map[string][]string, channelID, category string) map[string][]string {
+	updated := make(map[string][]string, len(categories)+1)
+	for name, ids := range categories {
+		var kept []string
+		for _, id := range ids {
+			if id != channelID {
+				kept = append(kept, id)
+			}
+		}
+		if len(kept) > 0 {
+			updated[name] = kept
+		}
+	}
+	if category != "" && category != uncategorized {
+		updated[category] = append(updated[category], channelID)
+	}
+	return updated
+}
+
+// categoryOf returns the category a channel is in, if any
+func categoryOf(categories map[string][]string, channelID string) string {
+	for _, name := range sortedCategories(categories) {
+		for _, id := range categories[name] {
+			if id == channelID {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// categoryView renders the form for assigning a channel to a category
+func (m SubscriptionModel) categoryView() string {
+	var sb strings.Builder
+
+	sub, _ := m.selected()
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("Category for " + sub.Title)
+
+	sb.WriteString(title)
+	sb.WriteString("\n\n")
+	sb.WriteString(m.categoryInput.View())
+	sb.WriteString("\n\n")
+
+	if names := sortedCategories(m.cfg.Categories); len(names) > 0 {
+		sb.WriteString(lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240")).
+			Render("Existing: " + strings.Join(names, ", ")))
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString(lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Render(helpLine(m.helpKeys())))
+
+	return lipgloss.NewStyle().
+		BorderStyle(panelBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(1).
+		Render(sb.String())
+}
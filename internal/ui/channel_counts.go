@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// channelCountsMsg carries the video counts of channels fetched for the manager
+type channelCountsMsg struct {
+	counts map[string]youtube.ChannelCount
+}
+
+// countStyle is used for the unwatched counts next to channel names
+var countStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240")).PaddingLeft(1)
+
+// resetCounts forgets the counts, which are filled in again as channels come
+// into view since the feed may have been refreshed in the meantime
+func (m *SubscriptionModel) resetCounts() {
+	m.counts = make(map[string]youtube.ChannelCount)
+	m.countsRequested = make(map[string]bool)
+	watched, err := m.youtubeClient.GetWatchedVideos()
+	if err != nil {
+		slog.Warn("couldn't load watched videos for the channel counts", "err", err)
+	}
+	m.watched = watched
+}
+
+// loadVisibleCounts fills in the counts of the channels in view. Channels
+// with cached videos are counted right away; the rest are fetched in the
+// background, through the same rate limiter as a refresh, so opening the
+// manager never fetches every channel at once.
+func (m SubscriptionModel) loadVisibleCounts() tea.Cmd {
+	if m.loading || m.counts == nil {
+		return nil
+	}
+
+	rows := m.rows()
+	startIdx, endIdx := m.visibleWindow(len(rows))
+	var uncached []string
+	for _, row := range rows[startIdx:endIdx] {
+		id := row.sub.ID
+		if row.header || row.sub.Unavailable || m.countsRequested[id] {
+			continue
+		}
+		if _, ok := m.counts[id]; ok {
+			continue
+		}
+		if count, ok := m.youtubeClient.CachedChannelCount(id, m.watched); ok {
+			m.counts[id] = count
+			continue
+		}
+		m.countsRequested[id] = true
+		uncached = append(uncached, id)
+	}
+	if len(uncached) == 0 {
+		return nil
+	}
+
+	watched := m.watched
+	return func() tea.Msg {
+		counts, err := m.youtubeClient.FetchChannelCounts(uncached, watched)
+		if err != nil {
+			// The counts are extras, the channels keep their dash
+			slog.Warn("couldn't fetch channel videos for the counts", "err", err)
+			return nil
+		}
+		return channelCountsMsg{counts: counts}
+	}
+}
+
+// countLabel returns the unwatched count shown after a channel's name, or a
+// dash while it isn't known yet
+func (m SubscriptionModel) countLabel(channelID string) string {
+	count, ok := m.counts[channelID]
+	if !ok {
+		return countStyle.Render(glyph("—"))
+	}
+	return countStyle.Render(fmt.Sprintf("%d unwatched", count.Unwatched))
+}
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// chaptersMsg carries the chapters of the video the chapter picker was opened for
+type chaptersMsg struct {
+	videoID  string
+	chapters []youtube.Chapter
+}
+
+// openChapters shows the chapter picker for a video and starts fetching its chapters
+func (m Model) openChapters(video youtube.Video) (Model, tea.Cmd) {
+	m.chapterVideo = &video
+	m.chapters = nil
+	m.chapterCursor = 0
+	m.chaptersLoading = true
+
+	return m, tea.Batch(
+		m.spinner.Tick,
+		func() tea.Msg {
+			chapters, err := m.youtubeClient.GetChapters(video.ID)
+			if err != nil {
+				return errMsg{err}
+			}
+			return chaptersMsg{videoID: video.ID, chapters: chapters}
+		},
+	)
+}
+
+// updateChapters handles keys while the chapter picker is open
+func (m Model) updateChapters(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "b", "H":
+		m.chapterVideo = nil
+		return m, nil
+
+	case "up", "k":
+		if m.chapterCursor > 0 {
+			m.chapterCursor--
+		}
+
+	case "down", "j":
+		if m.chapterCursor < len(m.chapters)-1 {
+			m.chapterCursor++
+		}
+
+	case "enter":
+		if m.chaptersLoading || len(m.chapters) == 0 {
+			return m, nil
+		}
+		video := *m.chapterVideo
+		chapter := m.chapters[m.chapterCursor]
+		m.chapterVideo = nil
+		return m.requestPlay(pendingPlay{video: video, kind: playChapter, chapter: chapter})
+	}
+
+	return m, nil
+}
+
+// playChapter plays the video in MPV from the start of the chapter
+func (m *Model) playChapter(video youtube.Video, chapter youtube.Chapter, quality youtube.StreamQuality) tea.Cmd {
+	m.notification = "Playing from " + chapter.FormatTimestamp() + "..."
+	m.notificationTimer = 3
+
+	return tea.Batch(
+		func() tea.Msg {
+			if err := m.youtubeClient.PlayVideoFrom(video, chapter.Start, quality); err != nil {
+				return errMsg{err}
+			}
+			return playedMsg{action: actionStream, video: video}
+		},
+		tea.Tick(time.Second, func(time.Time) tea.Msg {
+			return tickMsg{}
+		}),
+	)
+}
+
+// chaptersView renders the chapter picker
+func (m Model) chaptersView() string {
+	var sb strings.Builder
+
+	sb.WriteString(lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("Chapters"))
+	sb.WriteString("\n")
+	sb.WriteString(channelStyle.Render(m.chapterVideo.Title))
+	sb.WriteString("\n\n")
+
+	bulletStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#25A065"))
+	timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(9)
+
+	help := helpText("up/down: choose", "Enter: play from chapter", "Esc: close")
+	switch {
+	case m.chaptersLoading:
+		sb.WriteString(m.spinner.View() + " Loading chapters...\n")
+		help = "Esc: close"
+	case len(m.chapters) == 0:
+		sb.WriteString("This video has no chapters.\n")
+		help = "Esc: close"
+	default:
+		for i, chapter := range m.chapters {
+			prefix := "  "
+			if i == m.chapterCursor {
+				prefix = marker(bulletStyle, "●", ">") + " "
+			}
+			sb.WriteString(fmt.Sprintf("%s%s%s\n", prefix, timeStyle.Render(chapter.FormatTimestamp()), chapter.Title))
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(help))
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		lipgloss.NewStyle().
+			BorderStyle(panelBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(1).
+			Render(sb.String()),
+	)
+}
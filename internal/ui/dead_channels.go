@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// subscriptionCheckMsg carries the result of checking every subscription
+type subscriptionCheckMsg struct {
+	check youtube.SubscriptionCheck
+	err   error
+}
+
+// checkSubscriptions opens the dead channel list and starts checking every
+// subscription against the API
+func (m SubscriptionModel) checkSubscriptions() (SubscriptionModel, tea.Cmd) {
+	m.deadMode = true
+	m.deadChecking = true
+	m.deadCheck = youtube.SubscriptionCheck{}
+	return m, tea.Batch(
+		m.spinner.Tick,
+		func() tea.Msg {
+			check, err := m.youtubeClient.CheckSubscriptions()
+			return subscriptionCheckMsg{check: check, err: err}
+		},
+	)
+}
+
+// updateDead handles keys while the dead channels are listed
+func (m SubscriptionModel) updateDead(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.deadMode = false
+		return m, nil
+
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "y":
+		if m.deadChecking || len(m.deadCheck.Dead) == 0 {
+			return m, nil
+		}
+		m.deadMode = false
+		channelIDs := m.deadCheck.Dead
+		return m, func() tea.Msg {
+			if err := m.youtubeClient.RemoveSubscriptions(channelIDs); err != nil {
+				return errMsg{err}
+			}
+			return unsubscribedMsg{channelIDs: channelIDs}
+		}
+	}
+
+	return m, nil
+}
+
+// deadView renders the subscriptions the API no longer knows about
+func (m SubscriptionModel) deadView() string {
+	var sb strings.Builder
+
+	sb.WriteString(lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("Subscription health check"))
+	sb.WriteString("\n\n")
+
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	if m.deadChecking {
+		sb.WriteString(m.spinner.View() + " Checking subscriptions...\n")
+		sb.WriteString(dimStyle.Render("\n" + helpLine([]key.Binding{helpKey("Esc", "cancel")})))
+		return m.deadPanel(sb.String())
+	}
+
+	sb.WriteString(fmt.Sprintf("%d valid, %d dead or invalid\n\n", len(m.deadCheck.Valid), len(m.deadCheck.Dead)))
+	if len(m.deadCheck.Dead) == 0 {
+		sb.WriteString("Every subscribed channel still exists.\n")
+	}
+
+	// Dead channels only have a name if it was cached before they disappeared
+	names := make(map[string]string, len(m.subscriptions))
+	for _, sub := range m.subscriptions {
+		if sub.Title != "" && sub.Title != sub.ID {
+			names[sub.ID] = sub.Title
+		}
+	}
+	for _, id := range m.deadCheck.Dead {
+		if name, ok := names[id]; ok {
+			sb.WriteString(channelStyle.Render(name))
+		}
+		sb.WriteString(dimStyle.Render(id))
+		sb.WriteString("\n")
+	}
+
+	help := helpLine([]key.Binding{
+		withEnabled(helpKey("y", fmt.Sprintf("unsubscribe %d channels", len(m.deadCheck.Dead))), len(m.deadCheck.Dead) > 0),
+		helpKey("Esc", "close"),
+	})
+	sb.WriteString(dimStyle.Render("\n" + help))
+
+	return m.deadPanel(sb.String())
+}
+
+// deadPanel wraps the health check content in a panel
+func (m SubscriptionModel) deadPanel(content string) string {
+	return lipgloss.NewStyle().
+		BorderStyle(panelBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(1).
+		Render(content)
+}
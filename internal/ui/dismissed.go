@@ -0,0 +1,159 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// dismissedListMsg carries the dismissed videos for the dismissed view
+type dismissedListMsg struct {
+	videos []youtube.Video
+}
+
+// dismissedMsg reports that a video was dismissed or restored
+type dismissedMsg struct {
+	video     youtube.Video
+	dismissed bool
+}
+
+// loadDismissed returns the dismissed video IDs, hidden from the feed
+func (m Model) loadDismissed() (map[string]bool, error) {
+	videos, err := m.youtubeClient.GetDismissed()
+	if err != nil {
+		return nil, err
+	}
+	dismissed := make(map[string]bool, len(videos))
+	for _, video := range videos {
+		dismissed[video.ID] = true
+	}
+	return dismissed, nil
+}
+
+// withoutDismissed drops the dismissed videos
+func withoutDismissed(videos []youtube.Video, dismissed map[string]bool) []youtube.Video {
+	if len(dismissed) == 0 {
+		return videos
+	}
+
+	filtered := make([]youtube.Video, 0, len(videos))
+	for _, video := range videos {
+		if !dismissed[video.ID] {
+			filtered = append(filtered, video)
+		}
+	}
+	return filtered
+}
+
+// dismissVideo hides the video from the feed, or restores it
+func (m Model) dismissVideo(video youtube.Video, dismiss bool) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if dismiss {
+			err = m.youtubeClient.DismissVideo(video)
+		} else {
+			err = m.youtubeClient.RestoreVideo(video.ID)
+		}
+		if err != nil {
+			return errMsg{err}
+		}
+		return dismissedMsg{video: video, dismissed: dismiss}
+	}
+}
+
+// openDismissed loads the dismissed videos for the dismissed view
+func (m Model) openDismissed() tea.Cmd {
+	return func() tea.Msg {
+		videos, err := m.youtubeClient.GetDismissed()
+		if err != nil {
+			return errMsg{err}
+		}
+		return dismissedListMsg{videos: videos}
+	}
+}
+
+// setDismissedItems fills the dismissed view
+func (m *Model) setDismissedItems(videos []youtube.Video) {
+	items := make([]list.Item, len(videos))
+	for i, video := range videos {
+		items[i] = m.videoItem(video)
+	}
+	m.dismissedList.SetItems(items)
+}
+
+// applyDismissed updates the feed and the dismissed view after a video was
+// dismissed or restored
+func (m Model) applyDismissed(msg dismissedMsg) (tea.Model, tea.Cmd) {
+	if m.dismissed == nil {
+		m.dismissed = make(map[string]bool)
+	}
+
+	if msg.dismissed {
+		// Keep the cursor where it was, now on the next video
+		index := m.list.Index()
+		m.dismissed[msg.video.ID] = true
+		m.setVideoItems()
+		if visible := len(m.list.VisibleItems()); index >= visible && visible > 0 {
+			index = visible - 1
+		}
+		m.list.Select(index)
+		return m.notify("Dismissed " + msg.video.Title + ", press , to restore it")
+	}
+
+	var selectedID string
+	if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+		selectedID = selectedItem.video.ID
+	}
+	delete(m.dismissed, msg.video.ID)
+	m.setVideoItems()
+	m.selectVideo(selectedID)
+
+	for i, listItem := range m.dismissedList.Items() {
+		if videoItem, ok := listItem.(Item); ok && videoItem.video.ID == msg.video.ID {
+			m.dismissedList.RemoveItem(i)
+			break
+		}
+	}
+	return m.notify("Restored " + msg.video.Title)
+}
+
+// updateDismissed handles keys while the dismissed view is open
+func (m Model) updateDismissed(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Let the dismissed list handle keys while filtering
+	if m.dismissedList.FilterState() == list.Filtering {
+		var cmd tea.Cmd
+		m.dismissedList, cmd = m.dismissedList.Update(msg)
+		return m, cmd
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "b", "esc", ",":
+		if m.dismissedList.FilterState() == list.FilterApplied {
+			m.dismissedList.ResetFilter()
+			return m, nil
+		}
+		m.showDismissed = false
+		return m, nil
+
+	case ".", "u":
+		if selectedItem, ok := m.dismissedList.SelectedItem().(Item); ok {
+			return m, m.dismissVideo(selectedItem.video, false)
+		}
+		return m, nil
+
+	case "enter":
+		selectedItem, ok := m.dismissedList.SelectedItem().(Item)
+		if !ok {
+			return m, nil
+		}
+		return m.requestStream(selectedItem.video)
+	}
+
+	var cmd tea.Cmd
+	m.dismissedList, cmd = m.dismissedList.Update(msg)
+	return m, cmd
+}
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// exportVideos returns the videos to export as a playlist: the queue when
+// anything is queued, otherwise the videos shown, respecting the active filter
+func (m Model) exportVideos() []youtube.Video {
+	if len(m.queue) > 0 {
+		return append([]youtube.Video(nil), m.queue...)
+	}
+	var videos []youtube.Video
+	for _, listItem := range m.list.VisibleItems() {
+		if videoItem, ok := listItem.(Item); ok {
+			videos = append(videos, videoItem.video)
+		}
+	}
+	return videos
+}
+
+// exportM3U writes the queue or the videos shown to an M3U file next to the
+// downloads, named after the current time
+func (m Model) exportM3U() tea.Cmd {
+	videos := m.exportVideos()
+	if len(videos) == 0 {
+		return nil
+	}
+	name := "ytviewer-" + m.clock.Now().Format("20060102-150405") + ".m3u"
+	return func() tea.Msg {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return errMsg{err}
+		}
+		dir := filepath.Join(homeDir, "Downloads", "ytviewer")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errMsg{err}
+		}
+		path := filepath.Join(dir, name)
+		if err := m.youtubeClient.ExportM3U(path, videos); err != nil {
+			return errMsg{err}
+		}
+		return clipboardMsg{message: fmt.Sprintf("Exported %d video%s to %s", len(videos), pluralize(len(videos)), path)}
+	}
+}
+
+// createPlaylist creates an unlisted playlist on the user's YouTube account
+// from the queue or the videos shown and copies its URL. The browser opens
+// to grant access the first time.
+func (m Model) createPlaylist() (Model, tea.Cmd) {
+	videos := m.exportVideos()
+	if len(videos) == 0 {
+		return m, nil
+	}
+	title := "ytviewer " + m.clock.Now().Format("Jan 2, 2006 15:04")
+
+	status := "Creating playlist..."
+	if len(videos) > youtube.MaxPlaylistVideos {
+		status = fmt.Sprintf("Creating playlist with the first %d videos...", youtube.MaxPlaylistVideos)
+	}
+	m, notifyCmd := m.notify(status)
+	return m, tea.Batch(notifyCmd, func() tea.Msg {
+		url, err := m.youtubeClient.CreatePlaylistFromVideos(title, videos)
+		if errors.Is(err, youtube.ErrOAuthNotConfigured) {
+			return warningMsg{message: err.Error()}
+		}
+		if err != nil && url == "" {
+			return errMsg{err}
+		}
+		if err != nil {
+			// The playlist exists with the videos added before the failure
+			return warningMsg{message: fmt.Sprintf("Playlist created without all the videos (%v): %s", err, url)}
+		}
+		if err := clipboard.WriteAll(url); err != nil {
+			return clipboardMsg{message: "Playlist created: " + url}
+		}
+		return clipboardMsg{message: "Playlist created, URL copied to clipboard"}
+	})
+}
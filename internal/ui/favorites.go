@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// starStyle marks favorite videos in the list
+var starStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700"))
+
+// favoritesMsg carries the favorite videos for the favorites view
+type favoritesMsg struct {
+	videos []youtube.Video
+}
+
+// starredMsg reports that a video was starred or unstarred
+type starredMsg struct {
+	video   youtube.Video
+	starred bool
+}
+
+// loadStarred returns the starred video IDs, used to show the ★ indicator
+func (m Model) loadStarred() (map[string]bool, error) {
+	videos, err := m.youtubeClient.GetStarred()
+	if err != nil {
+		return nil, err
+	}
+	starred := make(map[string]bool, len(videos))
+	for _, video := range videos {
+		starred[video.ID] = true
+	}
+	return starred, nil
+}
+
+// toggleStar stars the video, or unstars it if it's already a favorite
+func (m Model) toggleStar(video youtube.Video) tea.Cmd {
+	starred := !m.starred[video.ID]
+	return func() tea.Msg {
+		var err error
+		if starred {
+			err = m.youtubeClient.StarVideo(video)
+		} else {
+			err = m.youtubeClient.UnstarVideo(video.ID)
+		}
+		if err != nil {
+			return errMsg{err}
+		}
+		return starredMsg{video: video, starred: starred}
+	}
+}
+
+// openFavorites loads the favorites for the favorites view
+func (m Model) openFavorites() tea.Cmd {
+	return func() tea.Msg {
+		videos, err := m.youtubeClient.GetStarred()
+		if err != nil {
+			return errMsg{err}
+		}
+		return favoritesMsg{videos: videos}
+	}
+}
+
+// setFavoriteItems fills the favorites view
+func (m *Model) setFavoriteItems(videos []youtube.Video) {
+	items := make([]list.Item, len(videos))
+	for i, video := range videos {
+		item := m.videoItem(video)
+		item.starred = true
+		items[i] = item
+	}
+	m.favorites.SetItems(items)
+}
+
+// setItemStarred updates the starred state of a video in the feed and the favorites view
+func (m *Model) setItemStarred(video youtube.Video, starred bool) {
+	if m.starred == nil {
+		m.starred = make(map[string]bool)
+	}
+	m.starred[video.ID] = starred
+
+	if i, ok := m.itemIndex[video.ID]; ok && i < len(m.list.Items()) {
+		if videoItem, ok := m.list.Items()[i].(Item); ok && videoItem.video.ID == video.ID {
+			videoItem.starred = starred
+			m.list.SetItem(i, videoItem)
+		}
+	}
+
+	// Unstarred videos drop out of the favorites view
+	if !starred {
+		for i, listItem := range m.favorites.Items() {
+			if videoItem, ok := listItem.(Item); ok && videoItem.video.ID == video.ID {
+				m.favorites.RemoveItem(i)
+				break
+			}
+		}
+	}
+}
+
+// updateFavorites handles keys while the favorites view is open
+func (m Model) updateFavorites(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Let the favorites list handle keys while filtering
+	if m.favorites.FilterState() == list.Filtering {
+		var cmd tea.Cmd
+		m.favorites, cmd = m.favorites.Update(msg)
+		return m, cmd
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "b", "esc", "F":
+		if m.favorites.FilterState() == list.FilterApplied {
+			m.favorites.ResetFilter()
+			return m, nil
+		}
+		m.showFavorites = false
+		return m, nil
+
+	case "*":
+		if selectedItem, ok := m.favorites.SelectedItem().(Item); ok {
+			return m, m.toggleStar(selectedItem.video)
+		}
+		return m, nil
+
+	case "enter":
+		selectedItem, ok := m.favorites.SelectedItem().(Item)
+		if !ok {
+			return m, nil
+		}
+		return m.requestStream(selectedItem.video)
+	}
+
+	var cmd tea.Cmd
+	m.favorites, cmd = m.favorites.Update(msg)
+	return m, cmd
+}
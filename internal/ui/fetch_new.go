@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// newVideosMsg carries the videos found by an incremental refresh
+type newVideosMsg struct {
+	videos []youtube.Video
+	seen   map[string]youtube.SeenVideo
+	result youtube.FetchResult
+}
+
+// newestPublished returns when the newest loaded video was published
+func newestPublished(videos []youtube.Video) time.Time {
+	var newest time.Time
+	for _, video := range videos {
+		if video.PublishedAt.After(newest) {
+			newest = video.PublishedAt
+		}
+	}
+	return newest
+}
+
+// fetchNewVideos checks for videos newer than the newest one loaded, leaving
+// the rest of the list alone
+func (m Model) fetchNewVideos() (Model, tea.Cmd) {
+	if m.fetchingNew {
+		return m, nil
+	}
+	m.fetchingNew = true
+	since := newestPublished(m.videos)
+	loaded := m.videos
+
+	m, notifyCmd := m.notify("Checking for new videos...")
+	return m, tea.Batch(notifyCmd, func() tea.Msg {
+		result, err := m.youtubeClient.GetVideosSince(since)
+		if err != nil {
+			return errMsg{err}
+		}
+
+		// Record the new videos as seen along with the rest of the feed, so
+		// they get the NEW badge and nothing else is forgotten
+		all := append(append([]youtube.Video(nil), loaded...), result.Videos...)
+		seen, _ := m.youtubeClient.RecordSeen(all)
+		return newVideosMsg{videos: result.Videos, seen: seen, result: result}
+	})
+}
+
+// applyNewVideos adds the videos found by an incremental refresh to the feed,
+// keeping the selection where it was
+func (m Model) applyNewVideos(msg newVideosMsg) (tea.Model, tea.Cmd) {
+	m.fetchingNew = false
+	for _, failed := range msg.result.Errors {
+		slog.Warn("channel failed to load", "channel", failed.ChannelID, "err", failed.Err)
+	}
+
+	switch {
+	case msg.result.Offline:
+		return m.notify("No network connection, no new videos fetched")
+	case !msg.result.QuotaExhaustedUntil.IsZero():
+		return m.notify("API quota exhausted, no new videos fetched")
+	}
+
+	loaded := make(map[string]bool, len(m.videos))
+	for _, video := range m.videos {
+		loaded[video.ID] = true
+	}
+	var added []youtube.Video
+	for _, video := range msg.videos {
+		if !loaded[video.ID] {
+			added = append(added, video)
+		}
+	}
+	if msg.seen != nil {
+		m.seen = msg.seen
+	}
+	m.fetchErrors = msg.result.Errors
+	if len(added) == 0 {
+		return m.notify("No new videos")
+	}
+
+	var selectedID string
+	if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+		selectedID = selectedItem.video.ID
+	}
+	m.videos = append(added, m.videos...)
+	m.setVideoItems()
+	m.selectVideo(selectedID)
+	return m.notify(fmt.Sprintf("%d new video%s", len(added), pluralize(len(added))))
+}
@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// filterVideos applies the feed filters from the config to the videos
+func (m Model) filterVideos(videos []youtube.Video) []youtube.Video {
+	videos = withoutDismissed(videos, m.dismissed)
+	if m.cfg.HideShorts {
+		videos = withoutShorts(videos)
+	}
+	videos = latestOnly(videos, m.latestOnly)
+	if m.category != "" {
+		videos = inCategory(videos, m.cfg.Categories, m.category)
+	}
+	if m.smartFeed {
+		videos = newerThanWatched(videos, m.watched)
+	}
+	return videos
+}
+
+// latestOnly keeps only the most recent upload from each of the given channels
+func latestOnly(videos []youtube.Video, channels map[string]bool) []youtube.Video {
+	if len(channels) == 0 {
+		return videos
+	}
+
+	// Find the newest video for each latest-only channel
+	newest := make(map[string]youtube.Video)
+	for _, video := range videos {
+		if !channels[video.ChannelID] {
+			continue
+		}
+		if current, ok := newest[video.ChannelID]; !ok || video.PublishedAt.After(current.PublishedAt) {
+			newest[video.ChannelID] = video
+		}
+	}
+
+	filtered := make([]youtube.Video, 0, len(videos))
+	for _, video := range videos {
+		if channels[video.ChannelID] && newest[video.ChannelID].ID != video.ID {
+			continue
+		}
+		filtered = append(filtered, video)
+	}
+	return filtered
+}
+
+// withoutShorts drops the videos detected as Shorts
+func withoutShorts(videos []youtube.Video) []youtube.Video {
+	filtered := make([]youtube.Video, 0, len(videos))
+	for _, video := range videos {
+		if !video.Short {
+			filtered = append(filtered, video)
+		}
+	}
+	return filtered
+}
+
+// newerThanWatched hides, per channel, the videos published before the newest
+// watched video from that channel, so caught-up channels only show new uploads
+func newerThanWatched(videos []youtube.Video, watched map[string]bool) []youtube.Video {
+	// Find when the newest watched video of each channel was published
+	newestWatched := make(map[string]youtube.Video)
+	for _, video := range videos {
+		if !watched[video.ID] {
+			continue
+		}
+		if current, ok := newestWatched[video.ChannelID]; !ok || video.PublishedAt.After(current.PublishedAt) {
+			newestWatched[video.ChannelID] = video
+		}
+	}
+	if len(newestWatched) == 0 {
+		return videos
+	}
+
+	filtered := make([]youtube.Video, 0, len(videos))
+	for _, video := range videos {
+		if newest, ok := newestWatched[video.ChannelID]; ok && video.PublishedAt.Before(newest.PublishedAt) {
+			continue
+		}
+		filtered = append(filtered, video)
+	}
+	return filtered
+}
+
+// noSelection explains why Enter didn't play anything. When a filter hides
+// every video it also offers to clear it, or clears it right away, depending
+// on enter_no_selection.
+func (m Model) noSelection() (Model, tea.Cmd) {
+	if m.list.FilterState() != list.Unfiltered && len(m.list.VisibleItems()) == 0 {
+		switch {
+		case m.cfg.EnterNoSelection == "clear" || (m.cfg.EnterNoSelection == "ask" && m.clearFilterOffered):
+			m.clearFilterOffered = false
+			m.list.ResetFilter()
+			return m.notify("Filter cleared")
+		case m.cfg.EnterNoSelection == "ask":
+			m.clearFilterOffered = true
+			return m.notify("No video matches the filter, press Enter again to clear it")
+		}
+		return m.notify("No video matches the filter")
+	}
+
+	// The feed filters can hide every video too
+	if len(m.videos) > 0 && len(m.list.Items()) == 0 {
+		return m.notify("No video selected, the category, smart feed or latest-only filters hide every video")
+	}
+	return m.notify("No video selected")
+}
+
+// inCategory keeps only videos from channels in the given category. The
+// uncategorized folder matches channels that aren't in any category.
+func inCategory(videos []youtube.Video, categories map[string][]string, category string) []youtube.Video {
+	members := make(map[string]bool)
+	if category == uncategorized {
+		for _, ids := range categories {
+			for _, id := range ids {
+				members[id] = true
+			}
+		}
+	} else {
+		for _, id := range categories[category] {
+			members[id] = true
+		}
+	}
+
+	filtered := make([]youtube.Video, 0, len(videos))
+	for _, video := range videos {
+		if members[video.ChannelID] != (category == uncategorized) {
+			filtered = append(filtered, video)
+		}
+	}
+	return filtered
+}
+
+// nextCategory returns the category after the active one, cycling through
+// all categories and back to "" (all videos)
+func nextCategory(categories map[string][]string, active string) string {
+	names := sortedCategories(categories)
+	if len(names) == 0 {
+		return ""
+	}
+	names = append(names, uncategorized)
+	if active == "" {
+		return names[0]
+	}
+	for i, name := range names {
+		if name == active && i+1 < len(names) {
+			return names[i+1]
+		}
+	}
+	return ""
+}
+
+// Bounds for adjusting the videos fetched per channel at runtime. The
+// uploads playlist returns at most 50 videos per request.
+const (
+	maxVideosStep  = 5
+	maxVideosLimit = 50
+)
+
+// feedTitle returns the main list title, including the active category and
+// the play profile
+func (m Model) feedTitle() string {
+	title := "YouTube Subscriptions"
+	if m.category != "" {
+		title += " · " + m.category
+	}
+	if m.cfg.PlayProfile != "" {
+		title += " · " + m.cfg.PlayProfile
+	}
+	return title
+}
+
+// showMaxVideos adds the videos fetched per channel to the status bar, which
+// then reads e.g. "97 videos · 10 per channel"
+func (m *Model) showMaxVideos() {
+	perChannel := fmt.Sprintf(" · %d per channel", m.youtubeClient.MaxVideosPerChannel())
+	m.list.SetStatusBarItemName("video"+perChannel, "videos"+perChannel)
+}
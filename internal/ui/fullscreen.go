@@ -0,0 +1,23 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// toggleFullscreen switches whether MPV opens fullscreen and saves it to the
+// config. Players that are already open keep their window.
+func (m Model) toggleFullscreen() (Model, tea.Cmd) {
+	fullscreen := !m.youtubeClient.Fullscreen()
+	m.youtubeClient.SetFullscreen(fullscreen)
+	m.cfg.MPVFullscreen = fullscreen
+
+	status := "MPV opens windowed"
+	if fullscreen {
+		status = "MPV opens fullscreen"
+	}
+	m, notifyCmd := m.notify(status)
+	return m, tea.Batch(
+		notifyCmd,
+		saveSetting("mpv_fullscreen", fullscreen),
+	)
+}
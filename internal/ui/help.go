@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// helpKey creates a binding that only carries help text for a key
+func helpKey(keys, desc string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys), key.WithHelp(keys, desc))
+}
+
+// helpLine renders the help of the enabled bindings as "key: action" pairs
+func helpLine(bindings []key.Binding) string {
+	parts := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		if !binding.Enabled() {
+			continue
+		}
+		help := binding.Help()
+		parts = append(parts, help.Key+": "+help.Desc)
+	}
+	return helpText(parts...)
+}
+
+// separator returns the bullet set between the parts of a line
+func separator() string {
+	return " " + glyph("•") + " "
+}
+
+// helpText joins the parts of a help line with separators
+func helpText(parts ...string) string {
+	return strings.Join(parts, separator())
+}
+
+// shortHelpKeys returns the keys worth showing below the video list in its
+// current state, next to the list's own navigation and filter keys
+func (m Model) shortHelpKeys() []key.Binding {
+	_, hasSelection := m.list.SelectedItem().(Item)
+	return []key.Binding{
+		withEnabled(helpKey("enter", "play"), hasSelection),
+		withEnabled(helpKey("a", "queue"), hasSelection && !m.queuePlaying),
+		withEnabled(helpKey("P", "play queue"), len(m.queue) > 0 && !m.queuePlaying && m.queueCountdown == 0),
+		withEnabled(helpKey("x", "stop queue"), m.queuePlaying || m.queueCountdown > 0),
+		withEnabled(helpKey("Z", "undo"), len(m.undoStack) > 0),
+		withEnabled(helpKey("e", "load errors"), len(m.fetchErrors) > 0),
+		withEnabled(helpKey("M", "players"), len(m.youtubeClient.Players()) > 0),
+		helpKey("s", "subscriptions"),
+	}
+}
+
+// helpKeys returns the keys that apply to the subscription manager's current mode
+func (m SubscriptionModel) helpKeys() []key.Binding {
+	switch {
+	case m.loading:
+		return []key.Binding{helpKey("b", "back"), helpKey("q", "quit")}
+	case m.addMode:
+		return []key.Binding{helpKey("Enter", "add"), helpKey("Esc", "cancel")}
+	case m.categoryMode:
+		return []key.Binding{helpKey("Enter", "save"), helpKey("Esc", "cancel")}
+	case m.snoozeMode:
+		return []key.Binding{helpKey("1-5", "snooze"), helpKey("Esc", "cancel")}
+	case m.jumpMode && m.letterJump:
+		return []key.Binding{helpKey("a-z", "jump to letter"), helpKey("Esc", "cancel")}
+	case m.jumpMode:
+		return []key.Binding{helpKey("type", "jump to channel"), helpKey("Enter", "stay here"), helpKey("Esc", "go back")}
+	}
+
+	sub, onChannel := m.selected()
+	rows := m.rows()
+	onHeader := m.folderView && m.cursor < len(rows) && rows[m.cursor].header
+	_, snoozed := m.youtubeClient.SnoozedUntil(sub.ID)
+	folders := "folders"
+	if m.folderView {
+		folders = "flat list"
+	}
+	collapse := "collapse"
+	if onHeader && m.collapsed[rows[m.cursor].category] {
+		collapse = "expand"
+	}
+
+	return []key.Binding{
+		helpKey("up/down", "navigate"),
+		helpKey("/", "jump"),
+		helpKey("'", "jump to letter"),
+		helpKey("[/]", "prev/next letter"),
+		withEnabled(helpKey("Enter", collapse), onHeader),
+		helpKey("a", "add channel"),
+		helpKey("v", "add from clipboard"),
+		withEnabled(helpKey("d", "unsubscribe"), onChannel),
+		withEnabled(helpKey("z", "snooze"), onChannel && !snoozed),
+		withEnabled(helpKey("z", "unsnooze"), onChannel && snoozed),
+		withEnabled(helpKey("c", "set category"), onChannel),
+		helpKey("S", "prune stale"),
+		helpKey("D", "check for dead channels"),
+		helpKey("g", folders),
+		helpKey("b", "back"),
+		helpKey("q", "quit"),
+	}
+}
+
+// withEnabled returns the binding enabled or disabled
+func withEnabled(binding key.Binding, enabled bool) key.Binding {
+	binding.SetEnabled(enabled)
+	return binding
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// SelectedVideo returns the video highlighted in the feed, if any
+func (m AppModel) SelectedVideo() (youtube.Video, bool) {
+	selectedItem, ok := m.videoModel.list.SelectedItem().(Item)
+	if !ok {
+		return youtube.Video{}, false
+	}
+	return selectedItem.video, true
+}
+
+// restoreLastSelected selects the video highlighted when ytviewer last
+// exited, or the shown video published closest to it once it has left the feed
+func (m *Model) restoreLastSelected() {
+	last := m.cfg.LastSelected
+	if last == nil || last.VideoID == "" {
+		return
+	}
+
+	nearest := -1
+	var nearestGap time.Duration
+	for i, listItem := range m.list.VisibleItems() {
+		videoItem, ok := listItem.(Item)
+		if !ok {
+			continue
+		}
+		if videoItem.video.ID == last.VideoID {
+			m.list.Select(i)
+			return
+		}
+		gap := videoItem.video.PublishedAt.Sub(last.PublishedAt)
+		if gap < 0 {
+			gap = -gap
+		}
+		if nearest < 0 || gap < nearestGap {
+			nearest, nearestGap = i, gap
+		}
+	}
+	if nearest >= 0 {
+		m.list.Select(nearest)
+	}
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/logging"
+)
+
+// logTailLines is how many of the most recent log lines the log view loads
+const logTailLines = 500
+
+// newLogViewport creates the scrollable viewport for the log view
+func newLogViewport(width, height int) viewport.Model {
+	vp := viewport.New(width, logViewportHeight(height))
+	vp.SetContent(loadLogContent())
+	vp.GotoBottom()
+	return vp
+}
+
+// logViewportHeight leaves room for the log view's title and help lines
+func logViewportHeight(height int) int {
+	if height > 4 {
+		return height - 4
+	}
+	return height
+}
+
+// loadLogContent reads the tail of the log file for display
+func loadLogContent() string {
+	lines, err := logging.Tail(logTailLines)
+	if err != nil {
+		return fmt.Sprintf("Error: %v", err)
+	}
+	if len(lines) == 0 {
+		return "The log is empty."
+	}
+	return strings.Join(lines, "\n")
+}
+
+// updateLogs handles keys while the log view is open
+func (m AppModel) updateLogs(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "L", "esc", "q", "b":
+		return m.popView()
+
+	case "r":
+		// Reload to pick up new log lines
+		m.logView.SetContent(loadLogContent())
+		m.logView.GotoBottom()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.logView, cmd = m.logView.Update(msg)
+	return m, cmd
+}
+
+// logsView renders the log view
+func (m AppModel) logsView() string {
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("Log")
+
+	path, _ := logging.Path()
+	help := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Render(helpText(path, glyph("↑")+"/"+glyph("↓")+": scroll", "r: reload", "L/Esc: close"))
+
+	return title + "\n\n" + m.logView.View() + "\n" + help
+}
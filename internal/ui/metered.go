@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// playKind is how a video is played once its quality is chosen
+type playKind int
+
+const (
+	playStream  playKind = iota // Stream it, MPV keeps playing in the background
+	playQueued                  // Play it as the next queued video, waiting for MPV to exit
+	playChapter                 // Stream it from a chapter
+	playURL                     // Stream a video from a pasted URL, offering to subscribe after
+	playArgs                    // Stream it with the arguments edited in the ! command view
+)
+
+// pendingPlay is playback that goes through the metered connection prompt
+type pendingPlay struct {
+	video   youtube.Video
+	kind    playKind
+	chapter youtube.Chapter // The chapter to play from, for playChapter
+	args    []string        // The player's arguments, for playArgs
+}
+
+// requestStream plays the video, first asking which quality to stream at
+// when metered_connection_warn is set
+func (m Model) requestStream(video youtube.Video) (Model, tea.Cmd) {
+	return m.requestPlay(pendingPlay{video: video, kind: playStream})
+}
+
+// requestPlay starts the playback, first asking which quality to stream at
+// when metered_connection_warn is set. Everything that streams goes through
+// here so the prompt can't be bypassed.
+func (m Model) requestPlay(play pendingPlay) (Model, tea.Cmd) {
+	if m.cfg.MeteredConnectionWarn {
+		m.confirmPlay = &play
+		return m, nil
+	}
+	return m.startPlay(play, youtube.QualityDefault)
+}
+
+// startPlay starts the playback at the chosen quality
+func (m Model) startPlay(play pendingPlay, quality youtube.StreamQuality) (Model, tea.Cmd) {
+	switch play.kind {
+	case playQueued:
+		return m, func() tea.Msg {
+			return queueVideoDoneMsg{video: play.video, err: m.youtubeClient.WatchVideo(play.video, quality)}
+		}
+	case playChapter:
+		cmd := m.playChapter(play.video, play.chapter, quality)
+		return m, cmd
+	case playURL:
+		cmd := m.launch(func() error {
+			return m.youtubeClient.PlayVideoAt(play.video, quality)
+		}, arbitraryPlayedMsg{video: play.video})
+		return m, cmd
+	case playArgs:
+		cmd := m.launch(func() error {
+			return m.youtubeClient.PlayVideoWithArgs(play.video, play.args, quality)
+		}, playedMsg{action: actionStream, video: play.video})
+		return m, cmd
+	default:
+		cmd := m.streamVideo(play.video, quality)
+		return m, cmd
+	}
+}
+
+// streamVideo plays the video in MPV at the given quality
+func (m *Model) streamVideo(video youtube.Video, quality youtube.StreamQuality) tea.Cmd {
+	return m.launch(func() error {
+		return m.youtubeClient.PlayVideoAt(video, quality)
+	}, playedMsg{action: actionStream, video: video})
+}
+
+// launch starts a player in the background, sending done once it's running
+func (m *Model) launch(play func() error, done tea.Msg) tea.Cmd {
+	m.notification = "Launching video..."
+	m.notificationTimer = 3
+
+	return tea.Batch(
+		func() tea.Msg {
+			if err := play(); err != nil {
+				return errMsg{err}
+			}
+			return done
+		},
+		tea.Tick(time.Second, func(time.Time) tea.Msg {
+			return tickMsg{}
+		}),
+	)
+}
+
+// updateConfirmPlay handles keys while asking which quality to stream at
+func (m Model) updateConfirmPlay(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	play := *m.confirmPlay
+
+	var quality youtube.StreamQuality
+	switch msg.String() {
+	case "enter", "y":
+		quality = youtube.QualityDefault
+	case "l":
+		quality = youtube.QualityLow
+	case "a":
+		quality = youtube.QualityAudioOnly
+	case "n", "esc":
+		m.confirmPlay = nil
+		if play.kind == playQueued {
+			// Put the video back and stop, rather than skip to the next one
+			m.queue = append([]youtube.Video{play.video}, m.queue...)
+			m.queuePlaying = false
+			m.refreshQueueActivity()
+		}
+		return m, nil
+	case "ctrl+c":
+		return m, tea.Quit
+	default:
+		return m, nil
+	}
+
+	m.confirmPlay = nil
+	return m.startPlay(play, quality)
+}
+
+// confirmPlayView renders the metered connection prompt
+func (m Model) confirmPlayView() string {
+	help := helpText(
+		"Enter: play "+m.defaultQualityLabel(),
+		"l: "+youtube.QualityLow.Label(),
+		"a: "+youtube.QualityAudioOnly.Label(),
+		"Esc: cancel")
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		lipgloss.NewStyle().
+			BorderStyle(panelBorder()).
+			BorderForeground(lipgloss.Color("#FF8700")).
+			Padding(1).
+			Render("Metered connection "+glyph("—")+" stream "+m.defaultQualityLabel()+"?\n\n"+
+				channelStyle.Render(m.confirmPlay.video.Title)+"\n\n"+
+				lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(help)),
+	)
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/config"
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+func TestMeteredPromptCoversEveryPlayPath(t *testing.T) {
+	video := youtube.Video{ID: "dQw4w9WgXcQ", Title: "A video"}
+	args := []string{"--no-video", video.URL()}
+
+	tests := []struct {
+		name string
+		play func(m Model) tea.Model
+		kind playKind
+	}{
+		{"enter", func(m Model) tea.Model {
+			m, _ = m.requestStream(video)
+			return m
+		}, playStream},
+		{"queue", func(m Model) tea.Model {
+			m.queue = []youtube.Video{video}
+			m, _ = m.playNextInQueue()
+			return m
+		}, playQueued},
+		{"chapter", func(m Model) tea.Model {
+			m.chapterVideo = &video
+			m.chapters = []youtube.Chapter{{Title: "Intro"}}
+			model, _ := m.updateChapters(tea.KeyMsg{Type: tea.KeyEnter})
+			return model
+		}, playChapter},
+		{"play URL", func(m Model) tea.Model {
+			model, _ := m.Update(playURLVideoMsg{video: video})
+			return model
+		}, playURL},
+		{"! command", func(m Model) tea.Model {
+			m.mpvCommandVideo = &video
+			model, _ := m.playWithMPVArgs(args)
+			return model
+		}, playArgs},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{cfg: &config.Config{MeteredConnectionWarn: true}}
+			got, ok := tt.play(m).(Model)
+			if !ok {
+				t.Fatalf("play returned %T, want Model", tt.play(m))
+			}
+			if got.confirmPlay == nil {
+				t.Fatal("played without asking about the quality")
+			}
+			if got.confirmPlay.kind != tt.kind || got.confirmPlay.video.ID != video.ID {
+				t.Errorf("prompt holds %+v, want a %v play of %s", *got.confirmPlay, tt.kind, video.ID)
+			}
+			if tt.kind == playArgs && len(got.confirmPlay.args) != len(args) {
+				t.Errorf("prompt holds arguments %v, want %v", got.confirmPlay.args, args)
+			}
+		})
+	}
+}
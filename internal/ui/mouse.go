@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// doubleClickInterval is the longest gap between two clicks on the same
+	// video that still counts as a double-click
+	doubleClickInterval = 400 * time.Millisecond
+
+	// subscriptionListTop is the row of the first channel in the subscription
+	// manager, below its border, padding, title and blank line
+	subscriptionListTop = 4
+)
+
+// updateMouse handles clicks and the scroll wheel in the video list. Clicking
+// a video selects it, double-clicking plays it.
+func (m Model) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	// Overlays and the filter prompt are keyboard only
+	if m.loading || m.err != nil || m.playURLMode || m.playedVideo != nil || m.showRelated || m.showFavorites || m.showDismissed || m.confirmPlay != nil || m.mpvCommandVideo != nil || m.transcriptVideo != nil || m.chapterVideo != nil ||
+		m.showErrors || m.showStats || m.showCaches || m.showPlayers || m.confirmWatched != nil || m.list.FilterState() == list.Filtering {
+		return m, nil
+	}
+
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		m.list.CursorUp()
+
+	case msg.Button == tea.MouseButtonWheelDown:
+		m.list.CursorDown()
+
+	case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress:
+		index, ok := listItemAt(m.list, newVideoDelegate(m.titleOverflow), msg.Y)
+		if !ok {
+			return m, nil
+		}
+		m.list.Select(index)
+
+		doubleClick := index == m.lastClickIndex && time.Since(m.lastClickAt) < doubleClickInterval
+		m.lastClickIndex = index
+		m.lastClickAt = time.Now()
+		if doubleClick {
+			// Play it exactly as Enter would
+			m.lastClickAt = time.Time{}
+			return m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		}
+	}
+
+	return m, m.loadSelectedThumbnail()
+}
+
+// listItemAt returns the index among the visible items of the video drawn at
+// row y of the list, if any. The list must be drawn with the given delegate,
+// whose height and spacing give the rows each video takes.
+func listItemAt(l list.Model, delegate list.ItemDelegate, y int) (int, bool) {
+	itemHeight := delegate.Height() + delegate.Spacing()
+	row := y - listHeaderHeight(l)
+	if row < 0 || row%itemHeight >= delegate.Height() {
+		return 0, false
+	}
+
+	onPage := row / itemHeight
+	if onPage >= l.Paginator.PerPage {
+		return 0, false
+	}
+	index := l.Paginator.Page*l.Paginator.PerPage + onPage
+	if index >= len(l.VisibleItems()) {
+		return 0, false
+	}
+	return index, true
+}
+
+// listHeaderHeight returns how many rows the list's title and status bar
+// take up above the first item
+func listHeaderHeight(l list.Model) int {
+	height := 0
+	if l.ShowTitle() || (l.ShowFilter() && l.FilteringEnabled()) {
+		height += lipgloss.Height(l.Styles.TitleBar.Render(l.Styles.Title.Render(l.Title)))
+	}
+	if l.ShowStatusBar() {
+		height += lipgloss.Height(l.Styles.StatusBar.Render(" "))
+	}
+	return height
+}
+
+// updateMouse handles clicks and the scroll wheel in the subscription manager.
+// Clicking a channel selects it, clicking a category header folds it.
+func (m SubscriptionModel) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.loading || m.err != nil || m.addMode || m.categoryMode || m.staleMode || m.deadMode || m.snoozeMode || m.jumpMode {
+		return m, nil
+	}
+
+	rows := m.rows()
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		if m.cursor > 0 {
+			m.cursor--
+		}
+
+	case msg.Button == tea.MouseButtonWheelDown:
+		if m.cursor < len(rows)-1 {
+			m.cursor++
+		}
+
+	case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionPress:
+		start, end := m.visibleWindow(len(rows))
+		index := start + msg.Y - subscriptionListTop
+		if msg.Y < subscriptionListTop || index >= end {
+			return m, nil
+		}
+		m.cursor = index
+		if row := rows[index]; row.header {
+			m.collapsed[row.category] = !m.collapsed[row.category]
+		}
+	}
+
+	return m, nil
+}
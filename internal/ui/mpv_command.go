@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// newMPVCommandInput creates the text input used to edit the MPV arguments
+// for a single play
+func newMPVCommandInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "mpv "
+	ti.CharLimit = 2000
+	return ti
+}
+
+// openMPVCommand shows the MPV command that playing the video would run
+func (m Model) openMPVCommand(video youtube.Video) (Model, tea.Cmd) {
+	m.mpvCommandVideo = &video
+	m.mpvCommandArgs = m.youtubeClient.MPVArgs(video)
+	m.mpvCommandInput.Prompt = m.youtubeClient.PlayerName() + " "
+	m.mpvCommandEditing = false
+	m.mpvCommandError = ""
+	return m, nil
+}
+
+// updateMPVCommand handles keys while the MPV command is shown or edited
+func (m Model) updateMPVCommand(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.mpvCommandEditing {
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			// Back to the command as built from the config
+			m.mpvCommandEditing = false
+			m.mpvCommandError = ""
+			m.mpvCommandInput.Blur()
+			return m, nil
+		case "enter":
+			args, err := youtube.SplitArgs(m.mpvCommandInput.Value())
+			if err != nil {
+				m.mpvCommandError = err.Error()
+				return m, nil
+			}
+			if len(args) == 0 {
+				m.mpvCommandError = "No arguments, the player needs at least the video URL"
+				return m, nil
+			}
+			return m.playWithMPVArgs(args)
+		}
+
+		var cmd tea.Cmd
+		m.mpvCommandInput, cmd = m.mpvCommandInput.Update(msg)
+		return m, cmd
+	}
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "!", "esc":
+		m.mpvCommandVideo = nil
+	case "enter":
+		return m.playWithMPVArgs(m.mpvCommandArgs)
+	case "e":
+		// Edit the arguments for this play only, the config is left alone
+		m.mpvCommandEditing = true
+		m.mpvCommandInput.Width = m.width - 12
+		m.mpvCommandInput.SetValue(youtube.QuoteArgs(m.mpvCommandArgs))
+		m.mpvCommandInput.CursorEnd()
+		return m, m.mpvCommandInput.Focus()
+	}
+	return m, nil
+}
+
+// playWithMPVArgs closes the command preview and plays its video with the given arguments
+func (m Model) playWithMPVArgs(args []string) (tea.Model, tea.Cmd) {
+	video := *m.mpvCommandVideo
+	m.mpvCommandVideo = nil
+	m.mpvCommandEditing = false
+	m.mpvCommandError = ""
+	m.mpvCommandInput.Blur()
+	return m.requestPlay(pendingPlay{video: video, kind: playArgs, args: args})
+}
+
+// mpvCommandView renders the MPV command preview, or the form editing it
+func (m Model) mpvCommandView() string {
+	var sb strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205"))
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240"))
+	width := m.width - 8
+	if width < 20 {
+		width = 20
+	}
+
+	sb.WriteString(titleStyle.Render("Player command"))
+	sb.WriteString("\n\n")
+	sb.WriteString(channelStyle.Render(m.mpvCommandVideo.Title))
+	sb.WriteString("\n\n")
+
+	if m.mpvCommandEditing {
+		sb.WriteString(m.mpvCommandInput.View())
+		sb.WriteString("\n\n")
+		if m.mpvCommandError != "" {
+			sb.WriteString(lipgloss.NewStyle().
+				Foreground(lipgloss.Color("9")).
+				Render(m.mpvCommandError))
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString(helpStyle.Render(helpLine([]key.Binding{
+			helpKey("Enter", "play once with these arguments"),
+			helpKey("Esc", "discard changes"),
+		})))
+	} else {
+		command := m.youtubeClient.PlayerName() + " " + youtube.QuoteArgs(m.mpvCommandArgs)
+		sb.WriteString(lipgloss.NewStyle().Width(width).Render(command))
+		sb.WriteString("\n\n")
+		sb.WriteString(helpStyle.Render(helpLine([]key.Binding{
+			helpKey("Enter", "play"),
+			helpKey("e", "edit for this play"),
+			helpKey("Esc", "close"),
+		})))
+	}
+
+	return lipgloss.NewStyle().
+		BorderStyle(panelBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(1).
+		Render(sb.String())
+}
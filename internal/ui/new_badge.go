@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// newBadgeStyle highlights videos that appeared in the feed recently
+var newBadgeStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#FFFDF5")).
+	Background(lipgloss.Color("#FF5F87")).
+	Bold(true).
+	Padding(0, 1)
+
+// isNew reports whether a video should be badged as new
+func (m Model) isNew(videoID string) bool {
+	entry, ok := m.seen[videoID]
+	if !ok {
+		return false
+	}
+	return entry.IsNew(time.Duration(m.cfg.NewBadgeHours)*time.Hour, m.clock.Now())
+}
+
+// clearNew drops the new badge from a video once the user interacts with it
+func (m *Model) clearNew(videoID string) tea.Cmd {
+	entry, ok := m.seen[videoID]
+	if !ok || entry.Cleared {
+		return nil
+	}
+	entry.Cleared = true
+	m.seen[videoID] = entry
+
+	if i, ok := m.itemIndex[videoID]; ok && i < len(m.list.Items()) {
+		if videoItem, ok := m.list.Items()[i].(Item); ok && videoItem.video.ID == videoID {
+			videoItem.isNew = false
+			m.list.SetItem(i, videoItem)
+		}
+	}
+
+	client := m.youtubeClient
+	return func() tea.Msg {
+		// Best effort, at worst the badge comes back after a restart
+		_ = client.ClearNew(videoID)
+		return nil
+	}
+}
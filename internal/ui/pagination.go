@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/paginator"
+)
+
+// fitPageSize shrinks the list until a page holds at most page_size videos,
+// since the list otherwise fits as many as its height allows. A window too
+// small for page_size videos keeps the height-based page size.
+func (m *Model) fitPageSize(width, height int) {
+	pageSize := m.cfg.PageSize
+	for height > 1 && pageSize > 0 && m.list.Paginator.PerPage > pageSize {
+		height--
+		m.list.SetSize(width, height)
+	}
+}
+
+// pageLabel returns the pagination format shown below the main list, e.g.
+// "Page 2/5 — videos 21–40 of 97". The page numbers are left for the
+// paginator to fill in.
+func (m Model) pageLabel() string {
+	total := len(m.list.VisibleItems())
+	if total == 0 {
+		return "Page %d/%d"
+	}
+	start := m.list.Paginator.Page*m.list.Paginator.PerPage + 1
+	end := start + m.list.Paginator.ItemsOnPage(total) - 1
+	return fmt.Sprintf("Page %%d/%%d %s videos %d%s%d of %d", glyph("—"), start, glyph("–"), end, total)
+}
+
+// usePageLabels switches the main list from pagination dots to page labels
+func (m *Model) usePageLabels() {
+	m.list.Paginator.Type = paginator.Arabic
+}
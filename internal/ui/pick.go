@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// pickMode is set by --pick, where Enter picks the selected video for a
+// shell pipeline instead of playing it
+var pickMode bool
+
+// SetPickMode makes Enter in the feed quit with the selected video picked,
+// see Picked. The TUI is drawn on stderr then, so the colors are detected
+// from it rather than from stdout, which is captured by the shell.
+func SetPickMode() {
+	pickMode = true
+
+	stderr := termenv.NewOutput(os.Stderr)
+	lipgloss.SetColorProfile(stderr.EnvColorProfile())
+	lipgloss.SetHasDarkBackground(stderr.HasDarkBackground())
+}
+
+// enterHelp describes what Enter does to the selected video in the feed
+func enterHelp() string {
+	if pickMode {
+		return "pick video"
+	}
+	return "play video"
+}
+
+// Picked returns the video picked with Enter in pick mode. It reports false
+// when ytviewer was quit without picking one.
+func (m AppModel) Picked() (youtube.Video, bool) {
+	if m.videoModel.picked == nil {
+		return youtube.Video{}, false
+	}
+	return *m.videoModel.picked, true
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/config"
+)
+
+// nextPlayProfile returns the profile after the active one in alphabetical order
+func nextPlayProfile(profiles map[string]config.PlayProfile, active string) string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return ""
+	}
+
+	for i, name := range names {
+		if name == active {
+			return names[(i+1)%len(names)]
+		}
+	}
+	return names[0]
+}
+
+// cyclePlayProfile switches to the next play profile and saves it to the config
+func (m Model) cyclePlayProfile() (Model, tea.Cmd) {
+	name := nextPlayProfile(m.cfg.PlayProfiles, m.cfg.PlayProfile)
+	if name == "" {
+		return m.notify("No play profiles configured")
+	}
+
+	profile := m.cfg.PlayProfiles[name]
+	m.cfg.PlayProfile = name
+	m.youtubeClient.SetPlayProfile(profile)
+	m.list.Title = m.feedTitle()
+
+	m, notifyCmd := m.notify("Play profile: " + name + " (" + profile.Summary() + ")")
+	return m, tea.Batch(notifyCmd, saveSetting("play_profile", name))
+}
+
+// defaultQualityLabel describes what plays without choosing a quality
+func (m Model) defaultQualityLabel() string {
+	if m.cfg.PlayProfile == "" {
+		return fmt.Sprintf("up to %dp", m.youtubeClient.MaxResolution())
+	}
+	return m.cfg.PlayProfiles[m.cfg.PlayProfile].Summary()
+}
@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// newPlayURLInput creates the text input used to play an arbitrary video
+func newPlayURLInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = "https://youtu.be/... or video ID"
+	ti.CharLimit = 200
+	ti.Width = 50
+	return ti
+}
+
+// updatePlayURL handles keys while the play-URL form or its follow-up prompt is open
+func (m Model) updatePlayURL(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Follow-up prompt after an arbitrary video has been launched
+	if m.playedVideo != nil {
+		video := *m.playedVideo
+		switch msg.String() {
+		case "m":
+			m.playedVideo = nil
+			return m, m.markWatched(video.ID)
+		case "a":
+			m.playedVideo = nil
+			if video.ChannelID == "" || m.youtubeClient.IsSubscribed(video.ChannelID) {
+				return m, nil
+			}
+			return m, func() tea.Msg {
+				if _, err := m.youtubeClient.AddSubscription(video.ChannelID); err != nil {
+					return errMsg{err}
+				}
+				return clipboardMsg{message: "Subscribed to " + video.ChannelName}
+			}
+		case "esc", "enter":
+			m.playedVideo = nil
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.playURLMode = false
+		m.playURLError = ""
+		return m, nil
+
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "enter":
+		videoID, err := youtube.ParseVideoID(m.playURLInput.Value())
+		if err != nil {
+			m.playURLError = err.Error()
+			return m, nil
+		}
+
+		m.playURLMode = false
+		m.playURLError = ""
+		m.notification = "Looking up video..."
+		m.notificationTimer = 3
+
+		return m, func() tea.Msg {
+			// Look up the video so we can offer to subscribe to its channel,
+			// but still play it if the lookup fails
+			video, err := m.youtubeClient.GetVideo(videoID)
+			if err != nil {
+				video = youtube.Video{ID: videoID, Title: videoID}
+			}
+			return playURLVideoMsg{video: video}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.playURLInput, cmd = m.playURLInput.Update(msg)
+	return m, cmd
+}
+
+// playURLView renders the play-URL form or its follow-up prompt
+func (m Model) playURLView() string {
+	var sb strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205"))
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240"))
+
+	if m.playedVideo != nil {
+		sb.WriteString(titleStyle.Render("Now playing"))
+		sb.WriteString("\n\n")
+		sb.WriteString(m.playedVideo.Title)
+		if m.playedVideo.ChannelName != "" {
+			sb.WriteString("\n" + channelStyle.Render(m.playedVideo.ChannelName))
+		}
+		sb.WriteString("\n\n")
+
+		help := "m: mark watched"
+		if m.playedVideo.ChannelID != "" && !m.youtubeClient.IsSubscribed(m.playedVideo.ChannelID) {
+			help += separator() + "a: subscribe to channel"
+		}
+		help += separator() + "Esc: done"
+		sb.WriteString(helpStyle.Render(help))
+	} else {
+		sb.WriteString(titleStyle.Render("Play a Video"))
+		sb.WriteString("\n\n")
+		sb.WriteString("Enter a YouTube URL or video ID:\n")
+		sb.WriteString(m.playURLInput.View())
+		sb.WriteString("\n\n")
+
+		if m.playURLError != "" {
+			sb.WriteString(lipgloss.NewStyle().
+				Foreground(lipgloss.Color("9")).
+				Render(m.playURLError))
+			sb.WriteString("\n\n")
+		}
+
+		sb.WriteString(helpStyle.Render(helpText("Press Enter to play", "Esc to cancel")))
+	}
+
+	return lipgloss.NewStyle().
+		BorderStyle(panelBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(1).
+		Render(sb.String())
+}
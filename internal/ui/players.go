@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// playerExitedMsg is sent when an MPV process started by ytviewer exits
+type playerExitedMsg struct {
+	video youtube.Video
+}
+
+// waitForPlayerExit waits for the next player to exit
+func waitForPlayerExit(client *youtube.Client) tea.Cmd {
+	return func() tea.Msg {
+		return playerExitedMsg{video: <-client.PlayerExits()}
+	}
+}
+
+// openPlayers shows the running players
+func (m Model) openPlayers() (Model, tea.Cmd) {
+	m.players = m.youtubeClient.Players()
+	if len(m.players) == 0 {
+		return m.notify("Nothing is playing")
+	}
+	m.playerCursor = 0
+	m.showPlayers = true
+	return m, nil
+}
+
+// refreshPlayers reloads the running players, closing the list once they have all exited
+func (m *Model) refreshPlayers() {
+	m.players = m.youtubeClient.Players()
+	if len(m.players) == 0 {
+		m.showPlayers = false
+	}
+	if m.playerCursor >= len(m.players) {
+		m.playerCursor = len(m.players) - 1
+	}
+	if m.playerCursor < 0 {
+		m.playerCursor = 0
+	}
+}
+
+// updatePlayers handles keys while the running players are listed
+func (m Model) updatePlayers(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "M", "esc":
+		m.showPlayers = false
+	case "up", "k":
+		if m.playerCursor > 0 {
+			m.playerCursor--
+		}
+	case "down", "j":
+		if m.playerCursor < len(m.players)-1 {
+			m.playerCursor++
+		}
+	case "x", "d":
+		player := m.players[m.playerCursor]
+		if err := m.youtubeClient.StopPlayer(player.PID); err != nil {
+			m.refreshPlayers()
+			return m.notify(err.Error())
+		}
+		// The list is refreshed once the player has exited
+		return m.notify("Stopped " + player.Video.Title)
+	case "X":
+		for _, player := range m.players {
+			_ = m.youtubeClient.StopPlayer(player.PID)
+		}
+		return m.notify("Stopped all players")
+	}
+	return m, nil
+}
+
+// playersStatusView renders the running player count shown below the list
+func (m Model) playersStatusView() string {
+	count := len(m.youtubeClient.Players())
+	if count == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Render(fmt.Sprintf("%s %d playing %s press M to manage", glyph("▶"), count, glyph("—")))
+}
+
+// playersView renders the list of running players
+func (m Model) playersView() string {
+	bulletStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#25A065"))
+	elapsedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	var sb strings.Builder
+	sb.WriteString(lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("Playing"))
+	sb.WriteString("\n\n")
+	for i, player := range m.players {
+		line := "  "
+		if i == m.playerCursor {
+			line = marker(bulletStyle, "●", ">") + " "
+		}
+		elapsed := time.Since(player.StartedAt).Truncate(time.Second)
+		line += channelStyle.Render(player.Video.Title) + elapsedStyle.Render(fmt.Sprintf("started %s ago", elapsed))
+		sb.WriteString(line + "\n")
+	}
+	sb.WriteString("\n")
+	sb.WriteString(elapsedStyle.Render(helpText("x: stop", "X: stop all", "M/Esc: close")))
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		lipgloss.NewStyle().
+			BorderStyle(panelBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(1, 2).
+			Render(sb.String()),
+	)
+}
@@ -0,0 +1,135 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// queueVideoDoneMsg is sent when MPV exits after playing a queued video
+type queueVideoDoneMsg struct {
+	video youtube.Video
+	err   error
+}
+
+// queueCountdownMsg ticks the countdown before the next queued video. The
+// generation ignores ticks from a countdown that has since been stopped.
+type queueCountdownMsg struct {
+	generation int
+}
+
+// enqueue adds a video to the end of the play queue
+func (m Model) enqueue(video youtube.Video) (Model, tea.Cmd) {
+	for _, queued := range m.queue {
+		if queued.ID == video.ID {
+			return m.notify("Already queued")
+		}
+	}
+	m.queue = append(m.queue, video)
+	m.refreshQueueActivity()
+	return m.notify(fmt.Sprintf("Queued (%d in queue)", len(m.queue)))
+}
+
+// playNextInQueue plays the first queued video and waits for MPV to exit
+func (m Model) playNextInQueue() (Model, tea.Cmd) {
+	if len(m.queue) == 0 {
+		m.queuePlaying = false
+		return m.notify("Queue finished")
+	}
+
+	video := m.queue[0]
+	m.queue = m.queue[1:]
+	m.refreshQueueActivity(video.ID)
+	m.queuePlaying = true
+	m.queueCountdown = 0
+	return m.requestPlay(pendingPlay{video: video, kind: playQueued})
+}
+
+// stopQueue stops chaining queued videos, the rest of the queue is kept
+func (m Model) stopQueue() (Model, tea.Cmd) {
+	m.queuePlaying = false
+	m.queueCountdown = 0
+	m.queueGeneration++
+	return m.notify(fmt.Sprintf("Queue stopped (%d left)", len(m.queue)))
+}
+
+// queueVideoDone marks the finished video and starts the countdown to the next one
+func (m Model) queueVideoDone(msg queueVideoDoneMsg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	if msg.err != nil {
+		m.err = msg.err
+		m.queuePlaying = false
+		return m, nil
+	}
+
+	// Only mark without asking, a prompt would stall the queue
+	if m.shouldMarkWatched(actionStream) == markWatchedYes {
+		cmds = append(cmds, m.markWatched(msg.video.ID))
+	}
+
+	if !m.queuePlaying || len(m.queue) == 0 {
+		m.queuePlaying = false
+		return m, tea.Batch(cmds...)
+	}
+
+	if m.cfg.QueueAutoplayDelay <= 0 {
+		var cmd tea.Cmd
+		m, cmd = m.playNextInQueue()
+		return m, tea.Batch(append(cmds, cmd)...)
+	}
+
+	m.queueCountdown = m.cfg.QueueAutoplayDelay
+	m.queueGeneration++
+	return m, tea.Batch(append(cmds, queueCountdownTick(m.queueGeneration))...)
+}
+
+// updateQueueCountdown counts down to the next queued video and plays it at zero
+func (m Model) updateQueueCountdown(msg queueCountdownMsg) (Model, tea.Cmd) {
+	if msg.generation != m.queueGeneration || m.queueCountdown == 0 {
+		return m, nil
+	}
+
+	m.queueCountdown--
+	if m.queueCountdown > 0 {
+		return m, queueCountdownTick(m.queueGeneration)
+	}
+	return m.playNextInQueue()
+}
+
+// queueCountdownTick schedules the next countdown tick
+func queueCountdownTick(generation int) tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return queueCountdownMsg{generation: generation}
+	})
+}
+
+// queueStatusView renders the queue status line shown below the list
+func (m Model) queueStatusView() string {
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FFFFFF")).
+		Background(lipgloss.Color("#336699")).
+		Padding(0, 1)
+
+	switch {
+	case m.queueCountdown > 0:
+		return statusStyle.Render(fmt.Sprintf("Next video in %ds %s press x to stop", m.queueCountdown, glyph("—")))
+	case m.queuePlaying:
+		return statusStyle.Render(fmt.Sprintf("Playing queue (%d left) %s press x to stop after this video", len(m.queue), glyph("—")))
+	case len(m.queue) > 0:
+		return statusStyle.Render(fmt.Sprintf("%d queued %s press P to play", len(m.queue), glyph("—")))
+	}
+	return ""
+}
+
+// notify shows a short notification
+func (m Model) notify(message string) (Model, tea.Cmd) {
+	m.notification = message
+	m.notificationTimer = 3
+	return m, tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// updateJump handles keys while typing a quick-jump query. Each keystroke
+// moves the cursor to the first channel matching the query so far.
+func (m SubscriptionModel) updateJump(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		// Cancel and go back to where the jump started
+		m.jumpMode = false
+		m.cursor = m.jumpOrigin
+		return m, nil
+
+	case tea.KeyEnter:
+		m.jumpMode = false
+		return m, nil
+
+	case tea.KeyBackspace:
+		if m.jumpQuery == "" {
+			m.jumpMode = false
+			return m, nil
+		}
+		runes := []rune(m.jumpQuery)
+		m.jumpQuery = string(runes[:len(runes)-1])
+
+	case tea.KeyRunes:
+		m.jumpQuery += string(msg.Runes)
+
+	case tea.KeySpace:
+		m.jumpQuery += " "
+
+	default:
+		// Navigation keys end the jump and move on from the match
+		m.jumpMode = false
+		return m.Update(msg)
+	}
+
+	m.jumpTo(m.jumpQuery)
+	return m, nil
+}
+
+// updateLetterJump handles the key pressed after ', jumping to the first
+// channel starting with it
+func (m SubscriptionModel) updateLetterJump(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.jumpMode = false
+	m.letterJump = false
+	if msg.Type != tea.KeyRunes || len(msg.Runes) == 0 {
+		// Esc or any other key cancels
+		return m, nil
+	}
+	m.jumpToInitial(msg.Runes[0])
+	return m, nil
+}
+
+// jumpToInitial moves the cursor to the first channel starting with the
+// letter. Without one, it lands on the first channel after where the letter
+// would be, so a missing letter still gets close.
+func (m *SubscriptionModel) jumpToInitial(letter rune) {
+	letter = unicode.ToUpper(letter)
+	rows := m.rows()
+	match := -1
+	for i, row := range rows {
+		if row.header {
+			continue
+		}
+		if initial(row) == letter {
+			match = i
+			break
+		}
+		if initial(row) > letter && (match < 0 || initial(row) < initial(rows[match])) {
+			match = i
+		}
+	}
+	if match < 0 {
+		return
+	}
+	m.cursor = match
+	m.jumpTarget = rows[match].sub.Title
+}
+
+// jumpTo moves the cursor to the first channel whose title starts with the
+// query, or failing that contains it. The cursor stays put if nothing matches.
+func (m *SubscriptionModel) jumpTo(query string) {
+	m.jumpTarget = ""
+	query = strings.ToLower(query)
+	if query == "" {
+		m.cursor = m.jumpOrigin
+		return
+	}
+
+	rows := m.rows()
+	match := -1
+	for i, row := range rows {
+		if !row.header && strings.HasPrefix(strings.ToLower(row.sub.Title), query) {
+			match = i
+			break
+		}
+	}
+	if match < 0 {
+		for i, row := range rows {
+			if !row.header && strings.Contains(strings.ToLower(row.sub.Title), query) {
+				match = i
+				break
+			}
+		}
+	}
+	if match < 0 {
+		return
+	}
+
+	m.cursor = match
+	m.jumpTarget = rows[match].sub.Title
+}
+
+// jumpLetter moves the cursor to the first channel of the next (or, with a
+// negative direction, previous) initial letter
+func (m *SubscriptionModel) jumpLetter(direction int) {
+	rows := m.rows()
+	if m.cursor < 0 || m.cursor >= len(rows) {
+		return
+	}
+	current := initial(rows[m.cursor])
+
+	if direction > 0 {
+		for i := m.cursor + 1; i < len(rows); i++ {
+			if !rows[i].header && initial(rows[i]) != current {
+				m.cursor = i
+				return
+			}
+		}
+		return
+	}
+
+	// Find the previous letter, then the first channel starting with it
+	i := m.cursor - 1
+	for i >= 0 && (rows[i].header || initial(rows[i]) == current) {
+		i--
+	}
+	if i < 0 {
+		return
+	}
+	previous := initial(rows[i])
+	for i > 0 && !rows[i-1].header && initial(rows[i-1]) == previous {
+		i--
+	}
+	m.cursor = i
+}
+
+// initial returns the upper-cased first letter of a channel row, used to
+// group channels alphabetically
+func initial(row subscriptionRow) rune {
+	for _, r := range row.sub.Title {
+		return unicode.ToUpper(r)
+	}
+	return 0
+}
+
+// jumpView renders the quick-jump query and where it landed
+func (m SubscriptionModel) jumpView() string {
+	if m.letterJump {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("Jump to letter: " + glyph("█"))
+	}
+	line := "Jump: " + m.jumpQuery + glyph("█")
+	switch {
+	case m.jumpQuery == "":
+	case m.jumpTarget == "":
+		line += "  (no match)"
+	default:
+		line += "  " + glyph("→") + " " + m.jumpTarget
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render(line)
+}
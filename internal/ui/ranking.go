@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// personalizeRanking moves the videos of the channels watched most lately up
+// the newest-first feed. Each channel's videos are ranked as if they were
+// published up to two days later, so a favorite's video from yesterday can
+// come before today's video from a channel rarely watched, but not before
+// anything from last week.
+func (m Model) personalizeRanking(videos []youtube.Video) []youtube.Video {
+	boosts, err := m.youtubeClient.ChannelBoosts()
+	if err != nil {
+		slog.Warn("personalized ranking unavailable", "err", err)
+		return videos
+	}
+	if len(boosts) == 0 {
+		return videos
+	}
+
+	ranked := func(video youtube.Video) time.Time {
+		return video.PublishedAt.Add(boosts[video.ChannelID])
+	}
+	sort.SliceStable(videos, func(i, j int) bool {
+		return ranked(videos[i]).After(ranked(videos[j]))
+	})
+	return videos
+}
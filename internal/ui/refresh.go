@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// softRefreshKey returns the key bound to the soft refresh
+func (m Model) softRefreshKey() string {
+	return m.cfg.SoftRefreshKeyOrDefault()
+}
+
+// hardRefreshKey returns the key bound to the hard refresh
+func (m Model) hardRefreshKey() string {
+	return m.cfg.HardRefreshKeyOrDefault()
+}
+
+// softRefresh checks each channel's feed for new uploads without using API
+// quota and only fetches the channels that have some
+func (m Model) softRefresh() (Model, tea.Cmd) {
+	m.err = nil
+	m.loading = true
+	return m, tea.Batch(
+		m.spinner.Tick,
+		func() tea.Msg {
+			result, err := m.youtubeClient.SoftRefresh()
+			if err != nil {
+				return errMsg{err}
+			}
+			msg := m.loadedVideos(result)
+			msg.refresh = fmt.Sprintf("Soft refresh: %d channel%s with new uploads fetched", result.Refetched, pluralize(result.Refetched))
+			return msg
+		},
+	)
+}
+
+// hardRefresh clears the video cache and fetches every channel
+func (m Model) hardRefresh() (Model, tea.Cmd) {
+	m.err = nil
+	m.loading = true
+	return m, tea.Batch(
+		m.spinner.Tick,
+		func() tea.Msg {
+			// Best effort, a stale cache file is overwritten by the fetch anyway
+			_ = m.youtubeClient.ClearVideoCache()
+			result, err := m.youtubeClient.GetLatestVideos()
+			if err != nil {
+				return errMsg{err}
+			}
+			msg := m.loadedVideos(result)
+			msg.refresh = "Hard refresh: cache cleared, every channel fetched"
+			return msg
+		},
+	)
+}
@@ -0,0 +1,72 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// channelProgressMsg carries where catching up on a channel left off
+type channelProgressMsg struct {
+	channelID   string
+	channelName string
+	progress    youtube.ChannelProgress
+	found       bool
+}
+
+// resumeChannel looks up where catching up on the selected video's channel left off
+func (m Model) resumeChannel() tea.Cmd {
+	selectedItem, ok := m.list.SelectedItem().(Item)
+	if !ok {
+		return nil
+	}
+	video := selectedItem.video
+	client := m.youtubeClient
+	return func() tea.Msg {
+		progress, err := client.GetChannelProgress()
+		if err != nil {
+			return errMsg{err}
+		}
+		channelProgress, found := progress[video.ChannelID]
+		return channelProgressMsg{
+			channelID:   video.ChannelID,
+			channelName: video.ChannelName,
+			progress:    channelProgress,
+			found:       found,
+		}
+	}
+}
+
+// applyChannelProgress selects the video to continue with in a channel: the
+// oldest unwatched video after the newest watched one, or the newest watched
+// one itself once the channel is caught up
+func (m Model) applyChannelProgress(msg channelProgressMsg) (Model, tea.Cmd) {
+	if !msg.found {
+		return m.notify("Nothing watched in " + msg.channelName + " yet")
+	}
+
+	var next *youtube.Video
+	for _, listItem := range m.list.VisibleItems() {
+		item, ok := listItem.(Item)
+		if !ok || item.video.ChannelID != msg.channelID || item.watched {
+			continue
+		}
+		if !item.video.PublishedAt.After(msg.progress.PublishedAt) {
+			continue
+		}
+		if next == nil || item.video.PublishedAt.Before(next.PublishedAt) {
+			video := item.video
+			next = &video
+		}
+	}
+
+	if next != nil {
+		m.selectVideo(next.ID)
+		return m.notify("Continuing " + msg.channelName + " after " + truncate(msg.progress.Title, 40))
+	}
+	if _, shown := m.itemIndex[msg.progress.VideoID]; shown {
+		m.selectVideo(msg.progress.VideoID)
+		return m.notify("Caught up on " + msg.channelName)
+	}
+	return m.notify("Caught up on " + msg.channelName + ", last watched " + truncate(msg.progress.Title, 40))
+}
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+const (
+	// reuploadWindow is how far apart a video and its re-upload can be published
+	reuploadWindow = 7 * 24 * time.Hour
+
+	// reuploadSimilarity is the share of title words two videos must have in
+	// common to be considered the same video
+	reuploadSimilarity = 0.8
+)
+
+// reuploadStyle marks videos that replaced an earlier upload
+var reuploadStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("240")).
+	Italic(true)
+
+// collapseReuploads hides videos that were re-uploaded by the same channel
+// shortly after, guessed from their titles. Only the newest upload is kept,
+// the returned map links it to the earliest upload it replaces.
+func collapseReuploads(videos []youtube.Video) ([]youtube.Video, map[string]youtube.Video) {
+	// Look at the newest uploads first so each group keeps its newest video
+	newestFirst := make([]youtube.Video, len(videos))
+	copy(newestFirst, videos)
+	sort.SliceStable(newestFirst, func(i, j int) bool {
+		return newestFirst[i].NewerThan(newestFirst[j])
+	})
+	words := make([]map[string]bool, len(newestFirst))
+	for i, video := range newestFirst {
+		words[i] = titleWords(video.Title)
+	}
+
+	hidden := make(map[string]bool)
+	reuploadOf := make(map[string]youtube.Video)
+	for i, newer := range newestFirst {
+		if hidden[newer.ID] {
+			continue
+		}
+		for j := i + 1; j < len(newestFirst); j++ {
+			older := newestFirst[j]
+			if hidden[older.ID] || older.ChannelID != newer.ChannelID || older.ID == newer.ID {
+				continue
+			}
+			if newer.PublishedAt.Sub(older.PublishedAt) > reuploadWindow {
+				continue
+			}
+			if sameNumbers(words[i], words[j]) && wordSimilarity(words[i], words[j]) >= reuploadSimilarity {
+				// Older uploads come later, so this ends on the earliest one
+				hidden[older.ID] = true
+				reuploadOf[newer.ID] = older
+			}
+		}
+	}
+	if len(hidden) == 0 {
+		return videos, nil
+	}
+
+	filtered := make([]youtube.Video, 0, len(videos)-len(hidden))
+	for _, video := range videos {
+		if !hidden[video.ID] {
+			filtered = append(filtered, video)
+		}
+	}
+	return filtered, reuploadOf
+}
+
+// titleWords returns the lowercased words of a title, ignoring punctuation
+func titleWords(title string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words[word] = true
+	}
+	return words
+}
+
+// wordSimilarity returns the share of words two titles have in common, from
+// 0 for nothing in common to 1 for the same words
+func wordSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for word := range a {
+		if b[word] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(a)+len(b)-common)
+}
+
+// sameNumbers reports whether two titles have the same words containing
+// digits. Series parts and episodes differ only in their number, "part 12"
+// and "part 13" are different videos however alike the rest of the title is.
+func sameNumbers(a, b map[string]bool) bool {
+	for word := range a {
+		if hasDigit(word) && !b[word] {
+			return false
+		}
+	}
+	for word := range b {
+		if hasDigit(word) && !a[word] {
+			return false
+		}
+	}
+	return true
+}
+
+// hasDigit reports whether the word contains a digit
+func hasDigit(word string) bool {
+	return strings.IndexFunc(word, unicode.IsDigit) >= 0
+}
+
+// truncate shortens s to at most n runes, ending it with an ellipsis when cut
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	ellipsis := glyph("…")
+	keep := n - len([]rune(ellipsis))
+	if keep < 0 {
+		keep = 0
+	}
+	return string(runes[:keep]) + ellipsis
+}
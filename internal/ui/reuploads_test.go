@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+func TestCollapseReuploads(t *testing.T) {
+	published := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	video := func(id, channelID, title string, age time.Duration) youtube.Video {
+		return youtube.Video{ID: id, ChannelID: channelID, Title: title, PublishedAt: published.Add(-age)}
+	}
+
+	tests := []struct {
+		name   string
+		videos []youtube.Video
+		want   []string // IDs left in the feed
+	}{
+		{
+			name: "re-upload with a fixed title",
+			videos: []youtube.Video{
+				video("new", "UCa", "Building a house in one day!", 0),
+				video("old", "UCa", "Building a house in one day", time.Hour),
+			},
+			want: []string{"new"},
+		},
+		{
+			name: "consecutive parts of a series",
+			videos: []youtube.Video{
+				video("p13", "UCa", "Minecraft let's play hardcore survival part 13", 0),
+				video("p12", "UCa", "Minecraft let's play hardcore survival part 12", 24*time.Hour),
+			},
+			want: []string{"p13", "p12"},
+		},
+		{
+			name: "number added to the title",
+			videos: []youtube.Video{
+				video("p2", "UCa", "Minecraft let's play hardcore survival 2", 0),
+				video("p1", "UCa", "Minecraft let's play hardcore survival", 24*time.Hour),
+			},
+			want: []string{"p2", "p1"},
+		},
+		{
+			name: "re-upload of a numbered part",
+			videos: []youtube.Video{
+				video("new", "UCa", "Minecraft let's play hardcore survival part 12 (fixed audio)", 0),
+				video("old", "UCa", "Minecraft let's play hardcore survival part 12", time.Hour),
+			},
+			want: []string{"new"},
+		},
+		{
+			name: "same title on another channel",
+			videos: []youtube.Video{
+				video("a", "UCa", "Building a house in one day", 0),
+				video("b", "UCb", "Building a house in one day", time.Hour),
+			},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "same title outside the window",
+			videos: []youtube.Video{
+				video("new", "UCa", "Weekly news roundup", 0),
+				video("old", "UCa", "Weekly news roundup", reuploadWindow+time.Hour),
+			},
+			want: []string{"new", "old"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, _ := collapseReuploads(tt.videos)
+			var got []string
+			for _, video := range filtered {
+				got = append(got, video.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("kept %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("kept %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCollapseReuploadsLinksOriginal(t *testing.T) {
+	published := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	videos := []youtube.Video{
+		{ID: "third", ChannelID: "UCa", Title: "My trip to Japan", PublishedAt: published},
+		{ID: "second", ChannelID: "UCa", Title: "My trip to Japan!", PublishedAt: published.Add(-time.Hour)},
+		{ID: "first", ChannelID: "UCa", Title: "My trip to Japan", PublishedAt: published.Add(-2 * time.Hour)},
+	}
+	filtered, reuploadOf := collapseReuploads(videos)
+	if len(filtered) != 1 || filtered[0].ID != "third" {
+		t.Fatalf("kept %v, want only the newest upload", filtered)
+	}
+	if original := reuploadOf["third"]; original.ID != "first" {
+		t.Errorf("newest upload replaces %q, want the earliest upload", original.ID)
+	}
+}
@@ -0,0 +1,28 @@
+package ui
+
+import (
+	"errors"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/config"
+)
+
+// settingNotSavedMsg reports a setting that wasn't saved because it was
+// edited in the config file while ytviewer was running
+type settingNotSavedMsg struct {
+	err error
+}
+
+// saveSetting saves a setting changed from the UI. It's best effort, at worst
+// the setting resets next launch, but an edit made to the config file in the
+// meantime is worth telling about rather than quietly kept over the UI's.
+func saveSetting(key string, value interface{}) tea.Cmd {
+	return func() tea.Msg {
+		var modified *config.ModifiedError
+		if err := config.Update(key, value); errors.As(err, &modified) {
+			return settingNotSavedMsg{err}
+		}
+		return nil
+	}
+}
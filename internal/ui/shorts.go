@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// toggleShorts hides or shows Shorts in the feed. The videos are already
+// fetched and flagged, so the list is rebuilt without fetching anything.
+func (m Model) toggleShorts() (Model, tea.Cmd) {
+	hide := !m.cfg.HideShorts
+	m.cfg.HideShorts = hide
+	m.setVideoItems()
+
+	status := "Showing Shorts"
+	if hide {
+		shorts := 0
+		for _, video := range m.videos {
+			if video.Short {
+				shorts++
+			}
+		}
+		status = fmt.Sprintf("Hiding %d Short%s", shorts, pluralize(shorts))
+	}
+	m, notifyCmd := m.notify(status)
+	return m, tea.Batch(
+		notifyCmd,
+		saveSetting("hide_shorts", hide),
+	)
+}
+
+// shortsHelp describes what the Shorts toggle does next
+func shortsHelp(hidden bool) string {
+	if hidden {
+		return "show shorts"
+	}
+	return "hide shorts"
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"sort"
+	"time"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// sortMode controls how videos are ordered in the main list
+type sortMode int
+
+const (
+	sortByDate     sortMode = iota // Newest first
+	sortByPremiere                 // Upcoming premieres by soonest, then newest first
+	sortRoundRobin                 // Newest video of each channel, then the next newest, and so on
+	sortModeCount
+)
+
+// String returns a human-readable name for the sort mode
+func (s sortMode) String() string {
+	switch s {
+	case sortByPremiere:
+		return "upcoming first"
+	case sortRoundRobin:
+		return "round-robin by channel"
+	default:
+		return "newest first"
+	}
+}
+
+// next returns the sort mode that follows s in the cycle
+func (s sortMode) next() sortMode {
+	return (s + 1) % sortModeCount
+}
+
+// sortVideos returns a copy of videos ordered according to the sort mode,
+// with the premieres still upcoming at now first in sortByPremiere
+func sortVideos(videos []youtube.Video, mode sortMode, now time.Time) []youtube.Video {
+	sorted := make([]youtube.Video, len(videos))
+	copy(sorted, videos)
+
+	switch mode {
+	case sortByPremiere:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			a, b := sorted[i], sorted[j]
+			aUpcoming, bUpcoming := a.IsUpcoming(now), b.IsUpcoming(now)
+			if aUpcoming != bUpcoming {
+				return aUpcoming
+			}
+			if aUpcoming {
+				return a.ScheduledStart.Before(b.ScheduledStart)
+			}
+			return a.NewerThan(b)
+		})
+	case sortRoundRobin:
+		return roundRobin(sorted)
+	default:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].NewerThan(sorted[j])
+		})
+	}
+
+	return sorted
+}
+
+// roundRobin interleaves the videos across channels: the newest video of every
+// channel first, then each channel's second newest, and so on. Within a round,
+// channels are ordered by their newest video, so a channel that posts a lot
+// can't crowd everyone else off the top of the feed.
+func roundRobin(videos []youtube.Video) []youtube.Video {
+	sort.SliceStable(videos, func(i, j int) bool {
+		return videos[i].NewerThan(videos[j])
+	})
+
+	// Group by channel, keeping channels in order of their newest video
+	var channels []string
+	byChannel := make(map[string][]youtube.Video)
+	for _, video := range videos {
+		if _, ok := byChannel[video.ChannelID]; !ok {
+			channels = append(channels, video.ChannelID)
+		}
+		byChannel[video.ChannelID] = append(byChannel[video.ChannelID], video)
+	}
+
+	interleaved := make([]youtube.Video, 0, len(videos))
+	for round := 0; len(interleaved) < len(videos); round++ {
+		for _, channelID := range channels {
+			if round < len(byChannel[channelID]) {
+				interleaved = append(interleaved, byChannel[channelID][round])
+			}
+		}
+	}
+	return interleaved
+}
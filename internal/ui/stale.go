@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// staleThresholds are the "no uploads in N months" choices for pruning
+var staleThresholds = []int{3, 6, 12, 24}
+
+// staleChannels returns the channels with no upload within the selected threshold
+func (m SubscriptionModel) staleChannels() []youtube.StaleChannel {
+	cutoff := m.clock.Now().AddDate(0, -m.staleMonths, 0)
+	return m.youtubeClient.StaleChannels(cutoff)
+}
+
+// updateStale handles keys while previewing stale channels for bulk removal
+func (m SubscriptionModel) updateStale(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.staleMode = false
+		return m, nil
+
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "1", "2", "3", "4":
+		m.staleMonths = staleThresholds[int(msg.String()[0]-'1')]
+		return m, nil
+
+	case "y":
+		stale := m.staleChannels()
+		m.staleMode = false
+		if len(stale) == 0 {
+			return m, nil
+		}
+
+		channelIDs := make([]string, len(stale))
+		for i, channel := range stale {
+			channelIDs[i] = channel.ID
+		}
+		return m, func() tea.Msg {
+			if err := m.youtubeClient.RemoveSubscriptions(channelIDs); err != nil {
+				return errMsg{err}
+			}
+			return unsubscribedMsg{channelIDs: channelIDs}
+		}
+	}
+
+	return m, nil
+}
+
+// staleView renders the preview of stale channels that would be unsubscribed
+func (m SubscriptionModel) staleView() string {
+	var sb strings.Builder
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render(fmt.Sprintf("Channels with no uploads in %d months", m.staleMonths))
+
+	sb.WriteString(title)
+	sb.WriteString("\n\n")
+
+	names := make(map[string]string, len(m.subscriptions))
+	for _, sub := range m.subscriptions {
+		names[sub.ID] = sub.Title
+	}
+
+	stale := m.staleChannels()
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	if len(stale) == 0 {
+		sb.WriteString("No stale channels found in the video cache.\n")
+	}
+	for _, channel := range stale {
+		name, ok := names[channel.ID]
+		if !ok {
+			name = channel.ID
+		}
+		lastUpload := "no uploads"
+		if !channel.LastUpload.IsZero() {
+			lastUpload = "last upload " + channel.LastUpload.Format("Jan 2, 2006")
+		}
+		sb.WriteString(channelStyle.Render(name))
+		sb.WriteString(dimStyle.Render(lastUpload))
+		sb.WriteString("\n")
+	}
+
+	help := "\n" + helpText(
+		fmt.Sprintf("1-4: %d/%d/%d/%d months", staleThresholds[0], staleThresholds[1], staleThresholds[2], staleThresholds[3]),
+		fmt.Sprintf("y: unsubscribe %d channels", len(stale)),
+		"Esc: cancel")
+	sb.WriteString(dimStyle.Render(help))
+
+	return lipgloss.NewStyle().
+		BorderStyle(panelBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(1).
+		Render(sb.String())
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// statsMsg carries the computed overview for the stats screen
+type statsMsg struct {
+	stats youtube.Stats
+}
+
+// loadStats computes the overview shown on the stats screen
+func (m Model) loadStats() tea.Cmd {
+	return func() tea.Msg {
+		stats, err := m.youtubeClient.GetStats()
+		if err != nil {
+			return errMsg{err}
+		}
+		return statsMsg{stats: stats}
+	}
+}
+
+// statsView renders the stats screen
+func (m Model) statsView() string {
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(25)
+	valueStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#25A065"))
+
+	s := m.stats
+	busiest := "none this week"
+	if s.BusiestChannel != "" {
+		busiest = fmt.Sprintf("%s (%d upload%s)", s.BusiestChannel, s.BusiestUploads, pluralize(s.BusiestUploads))
+	}
+	mostWatched := "none this month"
+	if len(s.MostWatched) > 0 {
+		channels := make([]string, len(s.MostWatched))
+		for i, channel := range s.MostWatched {
+			channels[i] = fmt.Sprintf("%s (%d)", channel.ChannelName, channel.Watched)
+		}
+		mostWatched = strings.Join(channels, ", ")
+	}
+
+	rows := []struct {
+		label string
+		value string
+	}{
+		{"Subscriptions", formatNumber(uint64(s.Subscriptions))},
+		{"Cached videos", formatNumber(uint64(s.CachedVideos))},
+		{"Unwatched", formatNumber(uint64(s.UnwatchedVideos))},
+		{"Watched this week", formatNumber(uint64(s.WatchedThisWeek))},
+		{"Busiest channel", busiest},
+		{"Most watched this month", mostWatched},
+		{"Quota used today", fmt.Sprintf("~%s / 10,000", formatNumber(uint64(s.QuotaUsedToday)))},
+		{"Data this session", "~" + formatBytes(s.DataThisSession)},
+		{"Data today", "~" + formatBytes(s.DataToday)},
+	}
+
+	var sb strings.Builder
+	sb.WriteString(lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("Stats"))
+	sb.WriteString("\n\n")
+	for _, row := range rows {
+		sb.WriteString(labelStyle.Render(row.label) + valueStyle.Render(row.value) + "\n")
+	}
+	sb.WriteString("\n")
+	sb.WriteString(lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Render("Press I or Esc to close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		lipgloss.NewStyle().
+			BorderStyle(panelBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(1, 2).
+			Render(sb.String()),
+	)
+}
@@ -1,7 +1,15 @@
 package ui
 
 import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/fabean/ytviewer/internal/config"
 )
 
 var (
@@ -48,4 +56,161 @@ var (
 		Foreground(subtle).
 		Italic(true).
 		PaddingLeft(1)
-) 
\ No newline at end of file
+
+	watchedStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888"))
+
+	activeStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FF8700"))
+)
+
+// newSpinner creates a spinner using the style and color from the config
+func newSpinner(cfg *config.Config) spinner.Model {
+	s := spinner.New()
+	style := cfg.SpinnerStyle
+	if asciiMode {
+		// The line spinner is the only one drawn in ASCII
+		style = "line"
+	}
+	switch style {
+	case "line":
+		s.Spinner = spinner.Line
+	case "jump":
+		s.Spinner = spinner.Jump
+	case "pulse":
+		s.Spinner = spinner.Pulse
+	default:
+		s.Spinner = spinner.Dot
+	}
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color(cfg.SpinnerColor))
+	return s
+}
+
+// asciiMode is set when only ASCII should be drawn, for terminals and fonts
+// without the symbols and box-drawing characters used otherwise
+var asciiMode bool
+
+// asciiGlyphs are the ASCII stand-ins for the symbols drawn in the UI
+var asciiGlyphs = map[string]string{
+	"●":        ">",
+	"✓":        "[x]",
+	"★":        "*",
+	"▾":        "v",
+	"▸":        ">",
+	"▶":        ">",
+	"█":        "_",
+	"→":        "->",
+	"↑":        "up",
+	"↓":        "down",
+	"⬇":        "dl",
+	"—":        "-",
+	"–":        "-",
+	"…":        "...",
+	"•":        "-",
+	"🔥 active": "! active",
+}
+
+// SetASCIIMode switches symbols, borders and the spinner to ASCII
+func SetASCIIMode() {
+	asciiMode = true
+	listStyle = listStyle.Border(lipgloss.ASCIIBorder())
+}
+
+// glyph returns the symbol, or its ASCII stand-in in ASCII mode
+func glyph(symbol string) string {
+	if ascii, ok := asciiGlyphs[symbol]; ok && asciiMode {
+		return ascii
+	}
+	return symbol
+}
+
+// panelBorder returns the border drawn around panels and prompts
+func panelBorder() lipgloss.Border {
+	if asciiMode {
+		return lipgloss.ASCIIBorder()
+	}
+	return lipgloss.RoundedBorder()
+}
+
+// colorProfiles maps the color_profile config values to terminal color profiles
+var colorProfiles = map[string]termenv.Profile{
+	"truecolor": termenv.TrueColor,
+	"256":       termenv.ANSI256,
+	"16":        termenv.ANSI,
+}
+
+// SetColorProfile limits the colors used to the given profile ("truecolor",
+// "256" or "16") instead of what the terminal reports supporting
+func SetColorProfile(name string) {
+	if profile, ok := colorProfiles[name]; ok {
+		lipgloss.SetColorProfile(profile)
+	}
+}
+
+// plainMarkers is set in no-color mode, where indicators are spelled out as
+// text instead of relying on color or symbols
+var plainMarkers bool
+
+// SetNoColor switches to the no-color mode for monochrome terminals and
+// low-vision users. Colors are stripped from everything rendered, bold,
+// underline and plain text markers are used instead.
+func SetNoColor() {
+	plainMarkers = true
+
+	// NO_COLOR makes lipgloss drop bold and underline too, keep those since
+	// the colors are stripped separately
+	lipgloss.SetColorProfile(termenv.ANSI)
+
+	titleStyle = titleStyle.Bold(true).Underline(true)
+	statusBarStyle = statusBarStyle.Bold(true)
+	selectedItemStyle = selectedItemStyle.Bold(true).Underline(true)
+	newBadgeStyle = newBadgeStyle.Padding(0)
+}
+
+// marker returns the styled symbol, or the plain text marker in no-color mode
+func marker(style lipgloss.Style, symbol, text string) string {
+	if plainMarkers {
+		return text
+	}
+	return style.Render(glyph(symbol))
+}
+
+// sgrPattern matches the SGR escape sequences that set colors and text attributes
+var sgrPattern = regexp.MustCompile(`\x1b\[([0-9;]*)m`)
+
+// stripColors removes the color parameters from the SGR sequences in s,
+// keeping attributes such as bold, underline and reverse
+func stripColors(s string) string {
+	return sgrPattern.ReplaceAllStringFunc(s, func(seq string) string {
+		params := sgrPattern.FindStringSubmatch(seq)[1]
+		if params == "" || params == "0" {
+			return seq
+		}
+
+		var kept []string
+		parts := strings.Split(params, ";")
+		for i := 0; i < len(parts); i++ {
+			n, err := strconv.Atoi(parts[i])
+			if err != nil {
+				continue
+			}
+			switch {
+			case n == 38 || n == 48 || n == 58:
+				// Extended colors take 2 (256 colors) or 4 (true color) more parameters
+				if i+1 < len(parts) && parts[i+1] == "5" {
+					i += 2
+				} else if i+1 < len(parts) && parts[i+1] == "2" {
+					i += 4
+				}
+			case n >= 30 && n <= 49, n >= 90 && n <= 107:
+				// Foreground and background colors
+			default:
+				kept = append(kept, parts[i])
+			}
+		}
+		if len(kept) == 0 {
+			return ""
+		}
+		return "\x1b[" + strings.Join(kept, ";") + "m"
+	})
+}
@@ -1,15 +1,18 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/fabean/ytviewer/internal/config"
 	"github.com/fabean/ytviewer/internal/youtube"
 )
 
@@ -37,6 +40,8 @@ func (i SubscriptionItem) Description() string {
 // SubscriptionModel represents the subscription manager UI state
 type SubscriptionModel struct {
 	youtubeClient *youtube.Client
+	cfg           *config.Config
+	clock         youtube.Clock // The client's clock, for snoozes and the stale cutoff
 	subscriptions []youtube.Subscription
 	loading       bool
 	spinner       spinner.Model
@@ -46,10 +51,66 @@ type SubscriptionModel struct {
 	cursor        int
 	offset        int
 	
+	// Incremental loading state
+	loadProgress  youtube.SubscriptionProgress
+	progressCh    <-chan youtube.SubscriptionProgress
+	cancelLoad    context.CancelFunc
+	
+	// Notice about the last action, shown below the list
+	notice      string
+	
 	// Add mode state
 	addMode     bool
 	channelInput textinput.Model
 	addError    string
+	
+	// Snooze picker state
+	snoozeMode  bool
+	
+	// Stale channel pruning state
+	staleMode   bool
+	staleMonths int
+	
+	// Subscription health check state
+	deadMode     bool
+	deadChecking bool
+	deadCheck    youtube.SubscriptionCheck
+	
+	// Category folder state
+	folderView    bool
+	collapsed     map[string]bool
+	categoryMode  bool
+	categoryInput textinput.Model
+	
+	// Quick-jump state
+	jumpMode   bool
+	letterJump bool // The jump waits for a single letter instead of a query
+	jumpQuery  string
+	jumpOrigin int    // Cursor position when the jump started, restored on Esc
+	jumpTarget string // Channel the query currently lands on
+	
+	// Per-channel video counts, filled in for the channels scrolled into view
+	counts          map[string]youtube.ChannelCount
+	countsRequested map[string]bool // Channels whose videos are being fetched
+	watched         map[string]bool
+}
+
+// subscriptionsVisible is how many rows of the subscription list are shown at once
+const subscriptionsVisible = 25
+
+// snoozeDuration is a choice in the snooze duration picker
+type snoozeDuration struct {
+	label    string
+	duration time.Duration
+}
+
+// snoozeDurations are the durations offered when snoozing a channel
+var snoozeDurations = []snoozeDuration{
+	{"1 day", 24 * time.Hour},
+	{"3 days", 3 * 24 * time.Hour},
+	{"1 week", 7 * 24 * time.Hour},
+	{"2 weeks", 14 * 24 * time.Hour},
+	{"1 month", 30 * 24 * time.Hour},
 }
 
 // formatNumber formats a number with commas (e.g., 1,234,567)
@@ -63,26 +124,30 @@ func formatNumber(n uint64) string {
 }
 
 // NewSubscriptionModel creates a new subscription manager model
-func NewSubscriptionModel(client *youtube.Client) SubscriptionModel {
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+func NewSubscriptionModel(client *youtube.Client, cfg *config.Config) SubscriptionModel {
+	s := newSpinner(cfg)
 	
 	// Initialize text input for channel ID
 	ti := textinput.New()
-	ti.Placeholder = "Enter YouTube Channel ID"
+	ti.Placeholder = "Channel ID, @handle or channel URL"
 	ti.Focus()
-	ti.CharLimit = 50
+	ti.CharLimit = 200 // Full channel URLs run past 50 characters
 	ti.Width = 30
 
 	return SubscriptionModel{
 		youtubeClient: client,
+		cfg:           cfg,
+		clock:         client.Clock(),
 		loading:       true,
 		spinner:       s,
 		cursor:        0,
 		offset:        0,
 		channelInput:  ti,
 		addMode:       false,
+		collapsed:     make(map[string]bool),
+		categoryInput: newCategoryInput(),
+		counts:          make(map[string]youtube.ChannelCount),
+		countsRequested: make(map[string]bool),
 	}
 }
 
@@ -94,36 +159,54 @@ func (m SubscriptionModel) Init() tea.Cmd {
 	)
 }
 
-// loadSubscriptions fetches channel information for subscriptions
+// loadSubscriptions starts loading channel information for subscriptions
 func (m SubscriptionModel) loadSubscriptions() tea.Cmd {
 	return func() tea.Msg {
-		// Get channel names with caching
-		channelNames, err := m.youtubeClient.GetSubscribedChannelNames()
-		if err != nil {
-			return errMsg{err}
+		ctx, cancel := context.WithCancel(context.Background())
+		return subscriptionLoadStartedMsg{
+			progress: m.youtubeClient.LoadSubscriptionInfo(ctx),
+			cancel:   cancel,
 		}
-		
-		// Create subscription objects
-		subscriptions := make([]youtube.Subscription, 0, len(channelNames))
-		for id, name := range channelNames {
-			subscriptions = append(subscriptions, youtube.Subscription{
-				ID:    id,
-				Title: name,
-				// Other fields can be left with zero values
-			})
+	}
+}
+
+// waitForSubscriptionProgress waits for the next incremental loading update
+func waitForSubscriptionProgress(progress <-chan youtube.SubscriptionProgress) tea.Cmd {
+	return func() tea.Msg {
+		p, ok := <-progress
+		if !ok {
+			return subscriptionLoadDoneMsg{progress: progress}
 		}
-		
-		// Sort subscriptions by name
-		sort.Slice(subscriptions, func(i, j int) bool {
-			return subscriptions[i].Title < subscriptions[j].Title
-		})
-		
-		return subscriptionsMsg{subscriptions: subscriptions}
+		return subscriptionProgressMsg{progress: progress, update: p}
 	}
 }
 
-// Update handles UI updates for the subscription manager
+// capturingInput reports whether keys are currently going to a text input or
+// an open prompt, so the view switching keys must leave them alone
+func (m SubscriptionModel) capturingInput() bool {
+	return m.addMode || m.categoryMode || m.jumpMode || m.snoozeMode || m.staleMode || m.deadMode
+}
+
+// CancelLoading stops any in-flight subscription loading
+func (m SubscriptionModel) CancelLoading() {
+	if m.cancelLoad != nil {
+		m.cancelLoad()
+	}
+}
+
+// Update handles UI updates for the subscription manager, then loads the
+// video counts of the channels that came into view
 func (m SubscriptionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	model, cmd := m.update(msg)
+	updated, ok := model.(SubscriptionModel)
+	if !ok {
+		return model, cmd
+	}
+	return updated, tea.Batch(cmd, updated.loadVisibleCounts())
+}
+
+// update handles a single message
+func (m SubscriptionModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
@@ -154,20 +237,7 @@ func (m SubscriptionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.loading = true
 				m.addError = ""
 				
-				return m, func() tea.Msg {
-					err := m.youtubeClient.AddSubscription(channelID)
-					if err != nil {
-						return errMsg{err}
-					}
-					
-					// Refresh subscriptions after adding
-					subscriptions, err := m.youtubeClient.GetSubscriptionInfo()
-					if err != nil {
-						return errMsg{err}
-					}
-					
-					return subscriptionsMsg{subscriptions: subscriptions}
-				}
+				return m, m.addChannel(channelID, false)
 			}
 			
 			// Handle text input
@@ -176,13 +246,62 @@ func (m SubscriptionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 		
+		// If assigning a category, keys go to the category form
+		if m.categoryMode {
+			return m.updateCategory(msg)
+		}
+		
+		// If quick-jumping, keys go to the jump query
+		if m.jumpMode && m.letterJump {
+			return m.updateLetterJump(msg)
+		}
+		if m.jumpMode {
+			return m.updateJump(msg)
+		}
+		
+		// If pruning stale channels, handle the preview keys
+		if m.staleMode {
+			return m.updateStale(msg)
+		}
+		
+		// If listing dead channels, handle the removal keys
+		if m.deadMode {
+			return m.updateDead(msg)
+		}
+		
+		// If picking a snooze duration, handle the choice
+		if m.snoozeMode {
+			switch msg.String() {
+			case "esc":
+				m.snoozeMode = false
+				return m, nil
+			case "1", "2", "3", "4", "5":
+				m.snoozeMode = false
+				choice := snoozeDurations[int(msg.String()[0]-'1')]
+				selectedChannel, ok := m.selected()
+				if !ok {
+					return m, nil
+				}
+				until := m.clock.Now().Add(choice.duration)
+				return m, func() tea.Msg {
+					err := m.youtubeClient.SnoozeChannel(selectedChannel.ID, until)
+					if err != nil {
+						return errMsg{err}
+					}
+					return snoozedMsg{}
+				}
+			}
+			return m, nil
+		}
+		
 		// Normal mode key handling
 		switch msg.String() {
 		case "q", "ctrl+c":
 			return m, tea.Quit
 			
 		case "b":
-			// Return to main view with a specific message
+			// Stop loading and return to main view with a specific message
+			m.CancelLoading()
 			return m, func() tea.Msg {
 				return returnToMainMsg{}
 			}
@@ -194,13 +313,27 @@ func (m SubscriptionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.channelInput.Focus()
 			return m, nil
 
+		case "v":
+			// Add the channel on the clipboard without going through the form
+			channel, ok := m.youtubeClient.ChannelFromClipboard()
+			m.addError = ""
+			m.channelInput.Reset()
+			if !ok {
+				m.addMode = true
+				m.addError = "The clipboard doesn't hold a channel ID, @handle or channel URL"
+				m.channelInput.Focus()
+				return m, nil
+			}
+			m.loading = true
+			return m, m.addChannel(channel, true)
+
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			if m.cursor < len(m.subscriptions)-1 {
+			if m.cursor < len(m.rows())-1 {
 				m.cursor++
 			}
 			
@@ -208,7 +341,7 @@ func (m SubscriptionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.cursor = 0
 			
 		case "end":
-			m.cursor = len(m.subscriptions) - 1
+			m.cursor = len(m.rows()) - 1
 			if m.cursor < 0 {
 				m.cursor = 0
 			}
@@ -223,47 +356,180 @@ func (m SubscriptionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "pgdown":
 			// Move down by 10 items
 			m.cursor += 10
-			if m.cursor >= len(m.subscriptions) {
-				m.cursor = len(m.subscriptions) - 1
-				if m.cursor < 0 {
-					m.cursor = 0
+			m.clampCursor()
+
+		case "/":
+			// Start a quick-jump, typing moves to the first matching channel
+			m.jumpMode = true
+			m.letterJump = false
+			m.jumpQuery = ""
+			m.jumpTarget = ""
+			m.jumpOrigin = m.cursor
+			return m, nil
+
+		case "'":
+			// Wait for a letter, then jump to the first channel starting with it.
+			// Letters on their own are taken by the other keys.
+			m.jumpMode = true
+			m.letterJump = true
+			m.jumpTarget = ""
+			m.jumpOrigin = m.cursor
+			return m, nil
+			
+		case "[":
+			// Jump to the channels starting with the previous letter
+			m.jumpLetter(-1)
+			
+		case "]":
+			// Jump to the channels starting with the next letter
+			m.jumpLetter(1)
+
+		case "g":
+			// Toggle grouping channels into category folders
+			m.folderView = !m.folderView
+			m.cursor = 0
+
+		case "enter", " ":
+			// Collapse or expand the category under the cursor
+			if rows := m.rows(); m.folderView && m.cursor < len(rows) && rows[m.cursor].header {
+				category := rows[m.cursor].category
+				m.collapsed[category] = !m.collapsed[category]
+			}
+
+		case "c":
+			// Assign the selected channel to a category
+			if sub, ok := m.selected(); ok {
+				m.categoryMode = true
+				m.categoryInput.SetValue(categoryOf(m.cfg.Categories, sub.ID))
+				m.categoryInput.CursorEnd()
+				m.categoryInput.Focus()
+				return m, textinput.Blink
+			}
+
+		case "z":
+			// Snooze the selected channel, or unsnooze it if already snoozed
+			if selectedChannel, ok := m.selected(); ok {
+				if _, snoozed := m.youtubeClient.SnoozedUntil(selectedChannel.ID); snoozed {
+					return m, func() tea.Msg {
+						err := m.youtubeClient.UnsnoozeChannel(selectedChannel.ID)
+						if err != nil {
+							return errMsg{err}
+						}
+						return snoozedMsg{}
+					}
 				}
+				m.snoozeMode = true
+				return m, nil
 			}
 
+		case "S":
+			// Preview stale channels for bulk removal
+			m.staleMode = true
+			if m.staleMonths == 0 {
+				m.staleMonths = 6
+			}
+			return m, nil
+
+		case "D":
+			// Check every subscription for deleted or invalid channels
+			return m.checkSubscriptions()
+
 		case "d":
 			// Unsubscribe from selected channel
-			if len(m.subscriptions) > 0 && m.cursor < len(m.subscriptions) {
-				selectedChannel := m.subscriptions[m.cursor]
+			if selectedChannel, ok := m.selected(); ok {
 				return m, func() tea.Msg {
 					err := m.youtubeClient.RemoveSubscription(selectedChannel.ID)
 					if err != nil {
 						return errMsg{err}
 					}
-					return unsubscribedMsg{channelID: selectedChannel.ID}
+					return unsubscribedMsg{channelIDs: []string{selectedChannel.ID}}
 				}
 			}
 		}
 
+	case tea.MouseMsg:
+		return m.updateMouse(msg)
+
 	case subscriptionsMsg:
 		m.subscriptions = msg.subscriptions
+		m.notice = msg.notice
+		m.loading = false
+
+	case subscriptionLoadStartedMsg:
+		// Cancel any previous load before starting a new one
+		m.CancelLoading()
+		m.progressCh = msg.progress
+		m.cancelLoad = msg.cancel
+		m.loadProgress = youtube.SubscriptionProgress{}
+		m.subscriptions = nil
+		m.loading = true
+		m.resetCounts()
+		return m, waitForSubscriptionProgress(msg.progress)
+
+	case subscriptionProgressMsg:
+		// Ignore updates from a cancelled load
+		if msg.progress != m.progressCh {
+			break
+		}
+		if msg.update.Err != nil {
+			m.err = msg.update.Err
+			m.loading = false
+			break
+		}
+		m.loadProgress = msg.update
+		m.subscriptions = append(m.subscriptions, msg.update.Subscriptions...)
+		return m, waitForSubscriptionProgress(msg.progress)
+
+	case subscriptionLoadDoneMsg:
+		if msg.progress != m.progressCh {
+			break
+		}
+		sortSubscriptions(m.subscriptions)
+		m.progressCh = nil
+		m.cancelLoad = nil
 		m.loading = false
 
 	case unsubscribedMsg:
-		// Remove the unsubscribed channel from the subscriptions
+		// Remove the unsubscribed channels from the subscriptions
+		removed := make(map[string]bool, len(msg.channelIDs))
+		for _, id := range msg.channelIDs {
+			removed[id] = true
+		}
 		var newSubscriptions []youtube.Subscription
 		for _, sub := range m.subscriptions {
-			if sub.ID != msg.channelID {
+			if !removed[sub.ID] {
 				newSubscriptions = append(newSubscriptions, sub)
 			}
 		}
 		m.subscriptions = newSubscriptions
 		
 		// Adjust cursor if needed
-		if m.cursor >= len(m.subscriptions) {
-			m.cursor = len(m.subscriptions) - 1
-			if m.cursor < 0 {
-				m.cursor = 0
-			}
+		m.clampCursor()
+
+	case subscriptionCheckMsg:
+		// Ignore a check that finished after the list was closed
+		if !m.deadMode {
+			break
+		}
+		if msg.err != nil {
+			m.deadMode = false
+			m.err = msg.err
+			break
+		}
+		m.deadChecking = false
+		m.deadCheck = msg.check
+
+	case quickAddFailedMsg:
+		// Fall back to the form with the channel filled in, so it can be fixed
+		m.loading = false
+		m.addMode = true
+		m.addError = msg.err.Error()
+		m.channelInput.SetValue(msg.channel)
+		m.channelInput.Focus()
+
+	case channelCountsMsg:
+		for channelID, count := range msg.counts {
+			m.counts[channelID] = count
 		}
 
 	case errMsg:
@@ -286,6 +552,10 @@ func (m SubscriptionModel) View() string {
 	}
 
 	if m.loading {
+		loadingText := m.cfg.LoadingSubscriptionsText
+		if m.loadProgress.Total > 0 {
+			loadingText += fmt.Sprintf(" Loaded %d/%d channels", m.loadProgress.Loaded, m.loadProgress.Total)
+		}
 		return lipgloss.Place(
 			m.width,
 			m.height,
@@ -293,9 +563,9 @@ func (m SubscriptionModel) View() string {
 			lipgloss.Center,
 			lipgloss.JoinVertical(
 				lipgloss.Center,
-				m.spinner.View()+" Loading subscriptions...",
+				m.spinner.View()+" "+loadingText,
 				"",
-				"Press q to quit",
+				helpLine(m.helpKeys()),
 			),
 		)
 	}
@@ -324,45 +594,65 @@ func (m SubscriptionModel) View() string {
 			sb.WriteString("\n\n")
 		}
 		
-		help := "Press Enter to add • Esc to cancel"
+		help := helpLine(m.helpKeys())
 		sb.WriteString(lipgloss.NewStyle().
 			Foreground(lipgloss.Color("240")).
 			Render(help))
 		
 		return lipgloss.NewStyle().
-			BorderStyle(lipgloss.RoundedBorder()).
+			BorderStyle(panelBorder()).
 			BorderForeground(lipgloss.Color("240")).
 			Padding(1).
 			Render(sb.String())
 	}
 
-	// Set a fixed number of visible items (25)
-	maxVisible := 25
+	// If assigning a category, show the category form
+	if m.categoryMode {
+		return m.categoryView()
+	}
 	
-	// Calculate start and end indices for pagination
-	startIdx := 0
-	if len(m.subscriptions) > maxVisible {
-		// Center the cursor in the visible window when possible
-		halfVisible := maxVisible / 2
-		startIdx = m.cursor - halfVisible
-		
-		// Adjust if we're near the beginning
-		if startIdx < 0 {
-			startIdx = 0
-		}
-		
-		// Adjust if we're near the end
-		if startIdx > len(m.subscriptions) - maxVisible {
-			startIdx = len(m.subscriptions) - maxVisible
-		}
+	// If pruning stale channels, show the preview
+	if m.staleMode {
+		return m.staleView()
 	}
 	
-	endIdx := startIdx + maxVisible
-	if endIdx > len(m.subscriptions) {
-		endIdx = len(m.subscriptions)
+	// If listing dead channels, show the health check
+	if m.deadMode {
+		return m.deadView()
 	}
 	
-	visibleSubs := m.subscriptions[startIdx:endIdx]
+	// If picking a snooze duration, show the picker
+	if m.snoozeMode {
+		var sb strings.Builder
+		selectedChannel, _ := m.selected()
+		
+		title := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("205")).
+			Render("Snooze " + selectedChannel.Title)
+		
+		sb.WriteString(title)
+		sb.WriteString("\n\n")
+		
+		for i, choice := range snoozeDurations {
+			sb.WriteString(fmt.Sprintf("%d: %s\n", i+1, choice.label))
+		}
+		
+		help := "\n" + helpLine(m.helpKeys())
+		sb.WriteString(lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240")).
+			Render(help))
+		
+		return lipgloss.NewStyle().
+			BorderStyle(panelBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(1).
+			Render(sb.String())
+	}
+
+	rows := m.rows()
+	startIdx, endIdx := m.visibleWindow(len(rows))
+	visibleRows := rows[startIdx:endIdx]
 	
 	// Build the view
 	var sb strings.Builder
@@ -382,19 +672,65 @@ func (m SubscriptionModel) View() string {
 	// Use the same channelStyle that's defined in ui.go
 	// This ensures consistency across the application
 	
-	for i, sub := range visibleSubs {
+	snoozeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Italic(true)
+	
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205"))
+	
+	for i, row := range visibleRows {
 		idx := i + startIdx
+		sub := row.sub
+		
+		// Category headers show whether the folder is collapsed
+		if row.header {
+			fold := glyph("▾")
+			if m.collapsed[row.category] {
+				fold = glyph("▸")
+			}
+			line := headerStyle.Render(fold + " " + row.category)
+			if idx == m.cursor {
+				line = marker(bulletStyle, "●", ">") + " " + line
+			} else {
+				line = "  " + line
+			}
+			sb.WriteString(line)
+			sb.WriteString("\n")
+			continue
+		}
+		
+		// Indent channels under their category header
+		indent := ""
+		if m.folderView {
+			indent = "  "
+		}
 		
 		// Style based on selection
 		var line string
 		if idx == m.cursor {
 			// Selected style with bullet
 			channelName := channelStyle.Render(sub.Title)
-			line = fmt.Sprintf("%s %s", bulletStyle.Render("●"), channelName)
+			if plainMarkers {
+				channelName = channelStyle.Bold(true).Underline(true).Render(sub.Title)
+			}
+			line = fmt.Sprintf("%s%s %s", indent, marker(bulletStyle, "●", ">"), channelName)
 		} else {
 			// Normal style with space for alignment
 			channelName := channelStyle.Render(sub.Title)
-			line = fmt.Sprintf("  %s", channelName)
+			line = fmt.Sprintf("%s  %s", indent, channelName)
+		}
+		
+		// Recent uploads, once they've been counted
+		if !sub.Unavailable {
+			line += m.countLabel(sub.ID)
+		}
+		
+		// Flag channels the API no longer returns, e.g. deleted channels
+		if sub.Unavailable {
+			line += snoozeStyle.Render("(channel unavailable)")
+		}
+		
+		// Show when snoozed channels come back
+		if until, snoozed := m.youtubeClient.SnoozedUntil(sub.ID); snoozed {
+			line += snoozeStyle.Render("(snoozed until " + until.Format("Jan 2") + ")")
 		}
 		
 		sb.WriteString(line)
@@ -402,33 +738,148 @@ func (m SubscriptionModel) View() string {
 	}
 	
 	// Pagination info
-	pagination := fmt.Sprintf("\n[%d/%d]", m.cursor+1, len(m.subscriptions))
+	pagination := fmt.Sprintf("\n[%d/%d]", m.cursor+1, len(rows))
 	sb.WriteString(lipgloss.NewStyle().
 		Foreground(lipgloss.Color("240")).
 		Render(pagination))
 	
-	// Help text
-	help := "\nup/down: navigate • a: add channel • d: unsubscribe • b: back • q: quit"
+	// Quick-jump query and its target
+	if m.jumpMode {
+		sb.WriteString("\n" + m.jumpView())
+	}
+	
+	// Notice about the last action
+	if m.notice != "" {
+		sb.WriteString(lipgloss.NewStyle().
+			Foreground(lipgloss.Color("214")).
+			Render("\n" + m.notice))
+	}
+	
+	// Help for the keys that apply to the selected row
+	help := "\n" + helpLine(m.helpKeys())
 	sb.WriteString(lipgloss.NewStyle().
 		Foreground(lipgloss.Color("240")).
 		Render(help))
 	
 	return lipgloss.NewStyle().
-		BorderStyle(lipgloss.RoundedBorder()).
+		BorderStyle(panelBorder()).
 		BorderForeground(lipgloss.Color("240")).
 		Padding(1).
 		Render(sb.String())
 }
 
+// visibleWindow returns the range of rows shown, a fixed-size window
+// centered on the cursor where possible
+func (m SubscriptionModel) visibleWindow(total int) (int, int) {
+	startIdx := 0
+	if total > subscriptionsVisible {
+		// Center the cursor in the visible window when possible
+		halfVisible := subscriptionsVisible / 2
+		startIdx = m.cursor - halfVisible
+		
+		// Adjust if we're near the beginning
+		if startIdx < 0 {
+			startIdx = 0
+		}
+		
+		// Adjust if we're near the end
+		if startIdx > total - subscriptionsVisible {
+			startIdx = total - subscriptionsVisible
+		}
+	}
+	
+	endIdx := startIdx + subscriptionsVisible
+	if endIdx > total {
+		endIdx = total
+	}
+	return startIdx, endIdx
+}
+
 // Message types
 type subscriptionsMsg struct {
 	subscriptions []youtube.Subscription
+	notice        string // Shown below the list, e.g. a warning about the added channel
+}
+
+// quickAddFailedMsg reports a channel from the clipboard that couldn't be added
+type quickAddFailedMsg struct {
+	channel string
+	err     error
+}
+
+type subscriptionLoadStartedMsg struct {
+	progress <-chan youtube.SubscriptionProgress
+	cancel   context.CancelFunc
+}
+
+type subscriptionProgressMsg struct {
+	progress <-chan youtube.SubscriptionProgress
+	update   youtube.SubscriptionProgress
+}
+
+type subscriptionLoadDoneMsg struct {
+	progress <-chan youtube.SubscriptionProgress
 }
 
 type unsubscribedMsg struct {
-	channelID string
+	channelIDs []string
 }
 
+type snoozedMsg struct{}
+
 type loadMainViewMsg struct{}
 
-type returnToMainMsg struct{} 
\ No newline at end of file
+type returnToMainMsg struct{}
+
+// addChannel subscribes to a channel given by ID, @handle or URL and reloads
+// the subscriptions. Channels quick-added from the clipboard are confirmed by
+// name, and fall back to the add form when they can't be added.
+func (m SubscriptionModel) addChannel(channel string, quick bool) tea.Cmd {
+	known := make(map[string]bool, len(m.subscriptions))
+	for _, sub := range m.subscriptions {
+		known[sub.ID] = true
+	}
+
+	return func() tea.Msg {
+		hasUploads, err := m.youtubeClient.AddSubscription(channel)
+		if err != nil {
+			if quick {
+				return quickAddFailedMsg{channel: channel, err: err}
+			}
+			return errMsg{err}
+		}
+		
+		// Refresh subscriptions after adding
+		subscriptions, err := m.youtubeClient.GetSubscriptionInfo()
+		if err != nil {
+			return errMsg{err}
+		}
+		
+		notice := ""
+		if quick {
+			notice = "Subscribed from the clipboard"
+			for _, sub := range subscriptions {
+				if !known[sub.ID] {
+					notice = "Subscribed to " + sub.Title
+					break
+				}
+			}
+		}
+		
+		// Explain up front why the channel won't show up in the feed
+		if !hasUploads {
+			if notice == "" {
+				notice = "Subscribed"
+			}
+			notice += ", but this channel has no public uploads yet"
+		}
+		return subscriptionsMsg{subscriptions: subscriptions, notice: notice}
+	}
+}
+
+// sortSubscriptions sorts subscriptions alphabetically by title
+func sortSubscriptions(subscriptions []youtube.Subscription) {
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return strings.ToLower(subscriptions[i].Title) < strings.ToLower(subscriptions[j].Title)
+	})
+}
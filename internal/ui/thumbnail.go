@@ -0,0 +1,242 @@
+package ui
+
+import (
+	"fmt"
+	"image"
+	_ "image/jpeg" // YouTube thumbnails are JPEGs
+	"net/http"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// thumbnailSize is how large the thumbnail preview next to the list is drawn
+type thumbnailSize string
+
+const (
+	thumbnailOff    thumbnailSize = ""
+	thumbnailSmall  thumbnailSize = "small"
+	thumbnailMedium thumbnailSize = "medium"
+	thumbnailLarge  thumbnailSize = "large"
+)
+
+// thumbnailSizes is the order the sizes are cycled through
+var thumbnailSizes = []thumbnailSize{thumbnailOff, thumbnailSmall, thumbnailMedium, thumbnailLarge}
+
+// width returns the preview width in columns, 0 when the preview is off
+func (s thumbnailSize) width() int {
+	switch s {
+	case thumbnailSmall:
+		return 24
+	case thumbnailMedium:
+		return 40
+	case thumbnailLarge:
+		return 60
+	default:
+		return 0
+	}
+}
+
+// String returns the size as shown in notifications
+func (s thumbnailSize) String() string {
+	if s == thumbnailOff {
+		return "off"
+	}
+	return string(s)
+}
+
+// next returns the size that follows s in the cycle
+func (s thumbnailSize) next() thumbnailSize {
+	for i, size := range thumbnailSizes {
+		if size == s {
+			return thumbnailSizes[(i+1)%len(thumbnailSizes)]
+		}
+	}
+	return thumbnailOff
+}
+
+// maxThumbnails is how many thumbnails are kept decoded in memory. Each
+// takes a few hundred KB at the larger thumbnail qualities.
+const maxThumbnails = 50
+
+// thumbnailCache holds the downloaded thumbnails by video ID, dropping the
+// least recently selected past maxThumbnails. A nil image marks a thumbnail
+// that is loading or unavailable. It's a pointer so copies of the model
+// share it, like the map it replaces.
+type thumbnailCache struct {
+	images map[string]image.Image
+	order  []string // Video IDs, least recently selected first
+}
+
+// newThumbnailCache returns an empty thumbnail cache
+func newThumbnailCache() *thumbnailCache {
+	return &thumbnailCache{images: make(map[string]image.Image)}
+}
+
+// get returns the thumbnail of a video, and whether it has been requested
+func (c *thumbnailCache) get(videoID string) (image.Image, bool) {
+	img, ok := c.images[videoID]
+	return img, ok
+}
+
+// put stores the thumbnail of a video as the most recently selected one,
+// dropping the least recently selected if the cache is full
+func (c *thumbnailCache) put(videoID string, img image.Image) {
+	if _, ok := c.images[videoID]; ok {
+		for i, id := range c.order {
+			if id == videoID {
+				c.order = append(c.order[:i], c.order[i+1:]...)
+				break
+			}
+		}
+	} else if len(c.order) >= maxThumbnails {
+		delete(c.images, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.images[videoID] = img
+	c.order = append(c.order, videoID)
+}
+
+// len returns how many thumbnails are cached
+func (c *thumbnailCache) len() int {
+	return len(c.images)
+}
+
+// thumbnailMsg carries a downloaded thumbnail, or nil if it couldn't be loaded
+type thumbnailMsg struct {
+	videoID string
+	image   image.Image
+}
+
+// cycleThumbnailSize switches to the next preview size and saves it to the config
+func (m Model) cycleThumbnailSize() (Model, tea.Cmd) {
+	m.thumbnailSize = m.thumbnailSize.next()
+	m.resizeList()
+
+	size := string(m.thumbnailSize)
+	m, notifyCmd := m.notify("Thumbnails: " + m.thumbnailSize.String())
+	return m, tea.Batch(
+		notifyCmd,
+		m.loadSelectedThumbnail(),
+		saveSetting("thumbnail_size", size),
+	)
+}
+
+// resizeList fits the list next to the thumbnail preview
+func (m *Model) resizeList() {
+	width := m.width
+	if previewWidth := m.thumbnailSize.width(); previewWidth > 0 {
+		width -= previewWidth + 2
+	}
+	m.list.SetSize(width, m.height-4)
+	m.fitPageSize(width, m.height-4)
+}
+
+// loadSelectedThumbnail downloads the selected video's thumbnail if the
+// preview is on and it hasn't been fetched yet
+func (m Model) loadSelectedThumbnail() tea.Cmd {
+	if m.thumbnailSize == thumbnailOff {
+		return nil
+	}
+	selectedItem, ok := m.list.SelectedItem().(Item)
+	if !ok || selectedItem.video.Thumbnail == "" {
+		return nil
+	}
+	video := selectedItem.video
+	if img, requested := m.thumbnails.get(video.ID); requested {
+		// Keep it from being the next one dropped
+		m.thumbnails.put(video.ID, img)
+		return nil
+	}
+
+	// Mark it as requested so moving back and forth doesn't download it twice
+	m.thumbnails.put(video.ID, nil)
+	return func() tea.Msg {
+		img, err := downloadThumbnail(video)
+		if err != nil {
+			return thumbnailMsg{videoID: video.ID}
+		}
+		return thumbnailMsg{videoID: video.ID, image: img}
+	}
+}
+
+// downloadThumbnail fetches and decodes a video's thumbnail
+func downloadThumbnail(video youtube.Video) (image.Image, error) {
+	client := http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(video.Thumbnail)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading thumbnail: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading thumbnail: %s", resp.Status)
+	}
+
+	img, _, err := image.Decode(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding thumbnail: %w", err)
+	}
+	return img, nil
+}
+
+// thumbnailView renders the selected video's thumbnail at the current size
+func (m Model) thumbnailView() string {
+	width := m.thumbnailSize.width()
+	// Half-block cells are two pixels tall, so a 16:9 image needs 9/32 rows per column
+	height := width * 9 / 32
+
+	placeholder := lipgloss.NewStyle().
+		Width(width).
+		Height(height).
+		Align(lipgloss.Center, lipgloss.Center).
+		Foreground(lipgloss.Color("240"))
+
+	selectedItem, ok := m.list.SelectedItem().(Item)
+	if !ok {
+		return placeholder.Render("")
+	}
+	img, requested := m.thumbnails.get(selectedItem.video.ID)
+	switch {
+	case plainMarkers:
+		return placeholder.Render("Thumbnails need colors")
+	case asciiMode:
+		return placeholder.Render("Thumbnails need Unicode")
+	case img != nil:
+		return renderHalfBlocks(img, width, height)
+	case requested && selectedItem.video.Thumbnail != "":
+		return placeholder.Render("Loading thumbnail...")
+	default:
+		return placeholder.Render("No thumbnail")
+	}
+}
+
+// renderHalfBlocks draws an image with "▀" characters, the foreground
+// coloring the top pixel of each cell and the background the bottom one
+func renderHalfBlocks(img image.Image, width, height int) string {
+	bounds := img.Bounds()
+	pixel := func(x, y int) (uint32, uint32, uint32) {
+		// Nearest-neighbor scaling is plenty at this size
+		sx := bounds.Min.X + x*bounds.Dx()/width
+		sy := bounds.Min.Y + y*bounds.Dy()/(height*2)
+		r, g, b, _ := img.At(sx, sy).RGBA()
+		return r >> 8, g >> 8, b >> 8
+	}
+
+	var sb strings.Builder
+	for row := 0; row < height; row++ {
+		for x := 0; x < width; x++ {
+			tr, tg, tb := pixel(x, row*2)
+			br, bg, bb := pixel(x, row*2+1)
+			fmt.Fprintf(&sb, "\x1b[38;2;%d;%d;%dm\x1b[48;2;%d;%d;%dm▀", tr, tg, tb, br, bg, bb)
+		}
+		sb.WriteString("\x1b[0m")
+		if row < height-1 {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// titleOverflow is what happens to titles too long for the list's width
+type titleOverflow string
+
+const (
+	titleEllipsis titleOverflow = "ellipsis" // Cut to one line, ending in …
+	titleWrap     titleOverflow = "wrap"     // Wrapped onto a second line, which is cut if needed
+)
+
+// parseTitleOverflow returns the overflow behavior of the config value,
+// cutting titles to one line by default
+func parseTitleOverflow(name string) titleOverflow {
+	if name == "" {
+		return titleEllipsis
+	}
+	return titleOverflow(name)
+}
+
+// fitTitle splits the title into the lines it's drawn on, none of them wider
+// than width cells
+func fitTitle(title string, width int, overflow titleOverflow) []string {
+	if width < 1 || lipgloss.Width(title) <= width {
+		return []string{title}
+	}
+	if overflow != titleWrap {
+		return []string{ellipsize(title, width)}
+	}
+	first, rest := breakLine(title, width)
+	return []string{first, ellipsize(rest, width)}
+}
+
+// ellipsize cuts s to width cells, ending it with an ellipsis when cut
+func ellipsize(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	ellipsis := glyph("…")
+	budget := width - lipgloss.Width(ellipsis)
+
+	var sb strings.Builder
+	used := 0
+	for _, r := range s {
+		w := lipgloss.Width(string(r))
+		if used+w > budget {
+			break
+		}
+		sb.WriteRune(r)
+		used += w
+	}
+	return strings.TrimRight(sb.String(), " ") + ellipsis
+}
+
+// breakLine splits s at the last space that fits in width cells, or mid-word
+// when the first word alone is wider
+func breakLine(s string, width int) (string, string) {
+	used := 0
+	lastSpace := -1
+	cut := len(s)
+	for i, r := range s {
+		w := lipgloss.Width(string(r))
+		if used+w > width {
+			cut = i
+			break
+		}
+		if r == ' ' {
+			lastSpace = i
+		}
+		used += w
+	}
+	if lastSpace > 0 {
+		return s[:lastSpace], strings.TrimLeft(s[lastSpace:], " ")
+	}
+	return s[:cut], s[cut:]
+}
@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+)
+
+// minShoutingLetters is the shortest all-caps word that is down-cased, so
+// acronyms like "AI" or "USA" are left alone
+const minShoutingLetters = 4
+
+// titleFormat controls how video titles are displayed. The video itself is
+// never changed, so filtering and playback still use the original title.
+type titleFormat struct {
+	normalize  bool // Down-case shouting words and collapse repeated punctuation
+	stripEmoji bool // Also remove emoji, only applies with normalize
+}
+
+// apply returns the title as it should be displayed
+func (f titleFormat) apply(title string) string {
+	if !f.normalize {
+		return title
+	}
+	if f.stripEmoji {
+		title = removeEmoji(title)
+	}
+	return downcaseShouting(collapsePunctuation(title))
+}
+
+// downcaseShouting lower-cases words written entirely in capitals, keeping
+// the first letter of the title capitalized
+func downcaseShouting(title string) string {
+	words := strings.Fields(title)
+	for i, word := range words {
+		if !isShouting(word) {
+			continue
+		}
+		lower := []rune(strings.ToLower(word))
+		if i == 0 {
+			for j, r := range lower {
+				if unicode.IsLetter(r) {
+					lower[j] = unicode.ToUpper(r)
+					break
+				}
+			}
+		}
+		words[i] = string(lower)
+	}
+	return strings.Join(words, " ")
+}
+
+// isShouting reports whether a word is all capitals and long enough not to be an acronym
+func isShouting(word string) bool {
+	letters := 0
+	for _, r := range word {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if !unicode.IsUpper(r) {
+			return false
+		}
+		letters++
+	}
+	return letters >= minShoutingLetters
+}
+
+// collapsePunctuation reduces runs of the same punctuation mark to a single
+// mark, except for periods which are kept as an ellipsis
+func collapsePunctuation(title string) string {
+	var b strings.Builder
+	var prev rune
+	run := 0
+	for _, r := range title {
+		if r == prev {
+			run++
+		} else {
+			run = 1
+		}
+		prev = r
+
+		limit := 1
+		if r == '.' {
+			limit = 3
+		}
+		if run > limit && unicode.IsPunct(r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// removeEmoji strips emoji and the joiners and variation selectors that
+// combine them, then tidies up the leftover spaces
+func removeEmoji(title string) string {
+	stripped := strings.Map(func(r rune) rune {
+		if isEmoji(r) {
+			return -1
+		}
+		return r
+	}, title)
+	return strings.Join(strings.Fields(stripped), " ")
+}
+
+// isEmoji reports whether r is an emoji or an emoji modifier
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Pictographs, emoticons, flags, skin tones
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // Miscellaneous symbols and dingbats
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // Arrows and stars such as ⭐
+		return true
+	case r == 0x200D || r == 0xFE0F || r == 0x20E3: // Joiner, variation selector, keycap
+		return true
+	}
+	return false
+}
+
+// titleFormat returns the title display options from the config
+func (m Model) titleFormat() titleFormat {
+	return titleFormat{
+		normalize:  m.cfg.NormalizeTitles,
+		stripEmoji: m.cfg.StripEmoji,
+	}
+}
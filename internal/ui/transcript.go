@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// transcriptMsg carries the transcript of the video the reader was opened for
+type transcriptMsg struct {
+	videoID string
+	lines   []youtube.TranscriptLine
+	err     error
+}
+
+// openTranscript shows the transcript reader for a video and starts fetching its captions
+func (m Model) openTranscript(video youtube.Video) (Model, tea.Cmd) {
+	m.transcriptVideo = &video
+	m.transcript = nil
+	m.transcriptErr = nil
+	m.transcriptLoading = true
+	m.transcriptView = viewport.New(m.width, transcriptViewportHeight(m.height))
+
+	return m, tea.Batch(
+		m.spinner.Tick,
+		func() tea.Msg {
+			lines, err := m.youtubeClient.GetTranscript(video.ID)
+			return transcriptMsg{videoID: video.ID, lines: lines, err: err}
+		},
+	)
+}
+
+// transcriptViewportHeight leaves room for the reader's title and help lines
+func transcriptViewportHeight(height int) int {
+	if height > 5 {
+		return height - 5
+	}
+	return height
+}
+
+// applyTranscript shows the fetched transcript, unless the reader has since
+// been closed or opened for another video
+func (m Model) applyTranscript(msg transcriptMsg) (tea.Model, tea.Cmd) {
+	if m.transcriptVideo == nil || m.transcriptVideo.ID != msg.videoID {
+		return m, nil
+	}
+	m.transcriptLoading = false
+	m.transcript = msg.lines
+	m.transcriptErr = msg.err
+	m.transcriptView.SetContent(m.transcriptContent())
+	return m, nil
+}
+
+// transcriptContent renders the transcript lines wrapped to the reader's width
+func (m Model) transcriptContent() string {
+	timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(9)
+	textStyle := lipgloss.NewStyle().Width(max(m.width-9, 20))
+
+	var sb strings.Builder
+	for _, line := range m.transcript {
+		sb.WriteString(lipgloss.JoinHorizontal(lipgloss.Top,
+			timeStyle.Render(line.FormatTimestamp()),
+			textStyle.Render(line.Text)))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// resizeTranscript fits the reader to a new window size
+func (m *Model) resizeTranscript() {
+	m.transcriptView.Width = m.width
+	m.transcriptView.Height = transcriptViewportHeight(m.height)
+	if len(m.transcript) > 0 {
+		m.transcriptView.SetContent(m.transcriptContent())
+	}
+}
+
+// updateTranscript handles keys while the transcript reader is open
+func (m Model) updateTranscript(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "b", "X":
+		m.transcriptVideo = nil
+		m.transcript = nil
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.transcriptView, cmd = m.transcriptView.Update(msg)
+	return m, cmd
+}
+
+// transcriptReaderView renders the transcript reader
+func (m Model) transcriptReaderView() string {
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("Transcript") + "  " + channelStyle.Render(m.transcriptVideo.Title)
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	var body string
+	switch {
+	case m.transcriptLoading:
+		body = m.spinner.View() + " Fetching captions..."
+	case errors.Is(m.transcriptErr, youtube.ErrNoTranscript):
+		body = "This video has no captions to read."
+	case m.transcriptErr != nil:
+		body = "Couldn't fetch the captions: " + m.transcriptErr.Error()
+	default:
+		return title + "\n\n" + m.transcriptView.View() + "\n" +
+			helpStyle.Render(helpText(glyph("↑")+"/"+glyph("↓")+"/PgUp/PgDn: scroll", "Esc: close"))
+	}
+
+	return title + "\n\n" + body + "\n\n" + helpStyle.Render("Esc: close")
+}
@@ -1,17 +1,23 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/fabean/ytviewer/internal/config"
 	"github.com/fabean/ytviewer/internal/youtube"
 )
 
@@ -22,6 +28,9 @@ import (
 type Model struct {
 	list         list.Model
 	youtubeClient *youtube.Client
+	cfg          *config.Config
+	clock        youtube.Clock // The client's clock, so relative times agree with its cache expiry and watch timestamps
+	titleOverflow titleOverflow // What happens to titles too long for the list
 	videos       []youtube.Video
 	loading      bool
 	spinner      spinner.Model
@@ -30,37 +39,191 @@ type Model struct {
 	height       int
 	notification string
 	notificationTimer int
+	fetchErrors  []youtube.ChannelError // Channels that failed to load
+	picked       *youtube.Video         // Video picked with Enter in pick mode
+	noUploads    map[string]string      // Channels that loaded but have no uploads yet, ID to name
+	seen         map[string]youtube.SeenVideo // When each video first appeared in the feed
+	quotaExhaustedUntil time.Time       // When the exhausted API quota resets, zero if it isn't exhausted
+	offline      bool                   // Whether the videos shown are cached because YouTube couldn't be reached
+	showErrors   bool                   // Whether the error details panel is open
+	showStats    bool                   // Whether the stats screen is open
+	stats        youtube.Stats          // Overview shown on the stats screen
+	showCaches   bool                   // Whether the cache maintenance panel is open
+	showPlayers  bool                   // Whether the running players are listed
+	collapseReuploads bool              // Whether videos re-uploaded by their channel are hidden
+	clearFilterOffered bool             // Whether Enter on an empty filtered list offered to clear the filter
+	reuploadOf   map[string]youtube.Video // Kept re-upload ID to the earliest upload it replaced
+	players      []youtube.Player       // Running players shown in the list
+	playerCursor int                    // Selected player
+	cacheStatus  youtube.CacheStatus    // Cache sizes shown on the maintenance panel
+	sortMode     sortMode               // How videos are ordered in the list
+	countdownTicking bool               // Whether the premiere countdown tick is scheduled
+	confirmWatched *youtube.Video       // Video awaiting a "mark as watched?" answer
+	confirmOnExit map[string]bool       // Streamed videos to ask about once their player exits
+	downloads    map[string]float64     // Percentage done of each download in progress, by video ID
+	confirmPlay  *pendingPlay           // Playback awaiting a quality choice on a metered connection
+	
+	// MPV command preview state
+	mpvCommandVideo   *youtube.Video // Video the command is shown for, nil when closed
+	mpvCommandArgs    []string       // Arguments built from the config
+	mpvCommandEditing bool           // Whether the arguments are being edited for a single play
+	mpvCommandInput   textinput.Model
+	mpvCommandError   string
+	undoStack    []youtube.WatchedChange // Watched changes this session, most recent last
+	
+	// Thumbnail preview state
+	thumbnailSize thumbnailSize
+	thumbnails    *thumbnailCache        // Downloaded thumbnails by video ID, the most recent maxThumbnails
+	
+	// Chapter picker state
+	chapterVideo    *youtube.Video // Video the picker is open for, nil when closed
+	chapters        []youtube.Chapter
+	chapterCursor   int
+	chaptersLoading bool
+	
+	// Transcript reader state
+	transcriptVideo   *youtube.Video // Video the reader is open for, nil when closed
+	transcript        []youtube.TranscriptLine
+	transcriptErr     error // Why the captions couldn't be fetched
+	transcriptLoading bool
+	transcriptView    viewport.Model
+	watched      map[string]bool        // Watched video IDs, loaded once per fetch
+	starred      map[string]bool        // Favorite video IDs, loaded once per fetch
+	dismissed    map[string]bool        // Video IDs hidden from the feed, loaded once per fetch
+	fetchingNew  bool                   // Whether an incremental refresh is running
+	restoreSelection bool               // Whether the first feed load selects the video highlighted last session
+	itemIndex    map[string]int         // Video ID to position in the list items
+	latestOnly   map[string]bool        // Channels that only show their newest upload
+	smartFeed    bool                   // Hide videos older than each channel's newest watched video
+	category     string                 // Only show videos from this category's channels, empty for all
+	
+	// Play queue state
+	queue           []youtube.Video
+	queuePlaying    bool // Whether finished videos chain into the next queued one
+	queueCountdown  int  // Seconds until the next queued video, 0 when not counting down
+	queueGeneration int
+	
+	// Last click, to detect double-clicks
+	lastClickIndex int
+	lastClickAt    time.Time
+	
+	// Play arbitrary URL state
+	playURLMode  bool
+	playURLInput textinput.Model
+	playURLError string
+	playedVideo  *youtube.Video // Arbitrary video just launched, awaiting follow-up
+	
+	// Related videos explorer state
+	related        list.Model
+	showRelated    bool
+	relatedLoading bool
+	
+	// Favorites view state
+	favorites     list.Model
+	showFavorites bool
+	dismissedList list.Model
+	showDismissed bool
 }
 
 // Item represents a video in the list
 type Item struct {
 	video youtube.Video
 	watched bool
+	isNew   bool // First appeared in the feed recently and not interacted with yet
+	starred bool // Kept as a favorite
+	format  titleFormat // How the title is displayed
+	showComments bool // Show the comment count and the active discussion badge
+	reuploadOf  string // Title of the earlier upload this video replaced, if it was collapsed
+	activity    itemActivity // Download or queue state, updated as it changes
+	clock       youtube.Clock // Tells the time the description is relative to
+	filterValue string
+}
+
+// newItem creates a list item for a video, precomputing its filter value
+func newItem(video youtube.Video, watched bool) Item {
+	return Item{
+		video:   video,
+		watched: watched,
+		// Combine title and channel name for filtering with channel name repeated
+		// to give it more weight in the search
+		filterValue: video.Title + " " + video.ChannelName + " " + video.ChannelName,
+	}
+}
+
+// videoItem creates a list item for a video with its watched, new and
+// favorite state and the display options from the config
+func (m Model) videoItem(video youtube.Video) Item {
+	item := newItem(video, m.watched[video.ID])
+	item.isNew = m.isNew(video.ID)
+	item.starred = m.starred[video.ID]
+	item.format = m.titleFormat()
+	item.showComments = m.cfg.ShowComments
+	if original, ok := m.reuploadOf[video.ID]; ok {
+		item.reuploadOf = original.Title
+	}
+	item.activity = m.activity(video.ID)
+	item.clock = m.clock
+	return item
 }
 
 // FilterValue returns the value to filter on
 func (i Item) FilterValue() string {
-	// Combine title and channel name for filtering with channel name repeated
-	// to give it more weight in the search
-	return i.video.Title + " " + i.video.ChannelName + " " + i.video.ChannelName
+	return i.filterValue
 }
 
-// Title returns the item title
+// Title returns the item title as it should be displayed
 func (i Item) Title() string {
-	return i.video.Title
+	return i.format.apply(i.video.Title)
 }
 
 // Description returns the item description
 func (i Item) Description() string {
-	timeAgo := formatTimeAgo(i.video.PublishedAt)
-	return fmt.Sprintf("%s • %s", 
-		channelStyle.Render(i.video.ChannelName),
-		dateStyle.Render(timeAgo))
+	now := i.clock.Now()
+	timeAgo := formatTimeAgo(i.video.PublishedAt, now)
+	if i.video.IsUpcoming(now) {
+		timeAgo = formatCountdown(i.video.ScheduledStart, now)
+	}
+	sep := separator()
+	desc := channelStyle.Render(i.video.ChannelName) + sep + dateStyle.Render(timeAgo)
+	
+	// Live streams have no length, and videos cached before durations were
+	// fetched don't know theirs until the next refresh
+	if i.video.Duration > 0 {
+		desc += sep + dateStyle.Render(i.video.FormatDuration())
+	}
+	
+	// Comment counts are only known for videos fetched since they were added
+	if i.showComments && i.video.CommentCount > 0 {
+		desc += sep + fmt.Sprintf("%s comment%s", formatNumber(i.video.CommentCount), pluralize(int(i.video.CommentCount)))
+		if i.video.ActiveDiscussion() {
+			desc += " " + marker(activeStyle, "🔥 active", "[active]")
+		}
+	}
+	
+	if i.reuploadOf != "" {
+		desc += sep + reuploadStyle.Render("re-upload of \""+truncate(i.reuploadOf, 40)+"\"")
+	}
+	return desc
 }
 
-// formatTimeAgo formats the time difference in a human-readable way
-func formatTimeAgo(t time.Time) string {
-	now := time.Now()
+// formatCountdown formats the time from now until a scheduled premiere
+func formatCountdown(t, now time.Time) string {
+	diff := t.Sub(now)
+
+	switch {
+	case diff <= 0:
+		return "premiering now"
+	case diff < time.Hour:
+		return fmt.Sprintf("premieres in %dm", int(diff.Minutes())+1)
+	case diff < 24*time.Hour:
+		return fmt.Sprintf("premieres in %dh %dm", int(diff.Hours()), int(diff.Minutes())%60)
+	default:
+		return fmt.Sprintf("premieres in %dd %dh", int(diff.Hours()/24), int(diff.Hours())%24)
+	}
+}
+
+// formatTimeAgo formats the time difference to now in a human-readable way
+func formatTimeAgo(t, now time.Time) string {
 	diff := now.Sub(t)
 
 	switch {
@@ -92,13 +255,14 @@ func pluralize(n int) string {
 type CustomDelegate struct {
 	list.DefaultDelegate
 	bulletStyle lipgloss.Style
+	overflow    titleOverflow // What happens to titles too long for the width
 }
 
 // Render overrides the default render method to add a bullet for selected items
 func (d CustomDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
 	// Add bullet or space at the beginning with reduced spacing
 	if index == m.Index() {
-		fmt.Fprint(w, d.bulletStyle.Render("●"))
+		fmt.Fprint(w, marker(d.bulletStyle, "●", ">"))
 	} else {
 		fmt.Fprint(w, " ")
 	}
@@ -109,21 +273,43 @@ func (d CustomDelegate) Render(w io.Writer, m list.Model, index int, listItem li
 		return
 	}
 	
-	// Render title with proper styling
-	title := item.Title()
+	style := d.Styles.NormalTitle
 	if index == m.Index() {
-		title = d.Styles.SelectedTitle.Render(title)
-	} else {
-		title = d.Styles.NormalTitle.Render(title)
+		style = d.Styles.SelectedTitle
+	}
+	
+	// Badge videos that only just appeared in the feed
+	badge := ""
+	if item.isNew {
+		badge = newBadgeStyle.Render(marker(lipgloss.NewStyle(), "NEW", "[NEW]")) + " "
+	}
+	
+	// Mark favorites with a star
+	suffix := ""
+	if item.starred {
+		suffix += " " + marker(starStyle, "★", "[starred]")
 	}
 	
 	// Add watched indicator if the video has been watched
-	if item.watched {
-		watchedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
-		title = title + " " + watchedStyle.Render("✓")
+	if item.watched && watchedDisplayStyle == watchedCheck {
+		suffix += " " + marker(watchedStyle, "✓", "[watched]")
 	}
 	
-	fmt.Fprintln(w, title)
+	// Show downloads in progress and queue positions
+	suffix += item.activity.indicator()
+	
+	// Fit the title in what the bullet, the markers and the padding leave
+	indent := lipgloss.Width(" ") + lipgloss.Width(badge)
+	if item.watched && watchedDisplayStyle == watchedPrefix {
+		indent += lipgloss.Width("[seen] ")
+	}
+	lines := fitTitle(item.Title(), m.Width()-indent-style.GetHorizontalFrameSize()-lipgloss.Width(suffix), d.overflow)
+	
+	fmt.Fprintln(w, badge+d.renderTitle(lines[0], item, style, index == m.Index(), true)+suffix)
+	if len(lines) > 1 {
+		// Line the wrapped title up with its first line
+		fmt.Fprintln(w, strings.Repeat(" ", indent)+d.renderTitle(lines[1], item, style, index == m.Index(), false))
+	}
 	
 	// Render description with proper indentation and styling
 	desc := item.Description()
@@ -139,12 +325,24 @@ func (d CustomDelegate) Render(w io.Writer, m list.Model, index int, listItem li
 	// No extra newline at the end - let the list handle spacing
 }
 
-// NewModel creates a new UI model
-func NewModel(client *youtube.Client) Model {
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+// renderTitle styles a line of the title, watched videos in the watched
+// style. The [seen] prefix only goes before the first line.
+func (d CustomDelegate) renderTitle(line string, item Item, style lipgloss.Style, selected, first bool) string {
+	switch {
+	case item.watched && (first || watchedDisplayStyle != watchedPrefix):
+		line = watchedTitle(line, style)
+	default:
+		line = style.Render(line)
+	}
+	if selected && plainMarkers {
+		// Without colors the selection needs more than the bullet to stand out
+		line = lipgloss.NewStyle().Bold(true).Underline(true).Render(line)
+	}
+	return line
+}
 
+// newVideoDelegate creates the delegate drawing the videos of a list
+func newVideoDelegate(overflow titleOverflow) CustomDelegate {
 	// Create a default delegate
 	defaultDelegate := list.NewDefaultDelegate()
 	
@@ -157,12 +355,21 @@ func NewModel(client *youtube.Client) Model {
 	delegate := CustomDelegate{
 		DefaultDelegate: defaultDelegate,
 		bulletStyle:     bulletStyle,
+		overflow:        overflow,
 	}
 	// Set spacing to 1 to add space between items
 	delegate.SetSpacing(1)
+	if overflow == titleWrap {
+		// Room for a title wrapped onto a second line
+		delegate.SetHeight(3)
+	}
+	return delegate
+}
 
-	l := list.New([]list.Item{}, delegate, 0, 0)
-	l.Title = "YouTube Subscriptions"
+// newVideoList creates a list of videos using the custom delegate and status bar styling
+func newVideoList(title string, overflow titleOverflow) list.Model {
+	l := list.New([]list.Item{}, newVideoDelegate(overflow), 0, 0)
+	l.Title = title
 	l.Styles.Title = titleStyle
 	
 	// Use the same style for both pagination and help
@@ -176,6 +383,21 @@ func NewModel(client *youtube.Client) Model {
 	// Make sure the pagination dots use the same style
 	l.Styles.ActivePaginationDot = statusStyle.Copy()
 	l.Styles.InactivePaginationDot = statusStyle.Copy()
+	if asciiMode {
+		l.Paginator.ActiveDot = statusStyle.Render("*")
+		l.Paginator.InactiveDot = statusStyle.Render(".")
+		l.Help.ShortSeparator = " - "
+	}
+
+	return l
+}
+
+// NewModel creates a new UI model
+func NewModel(client *youtube.Client, cfg *config.Config) Model {
+	s := newSpinner(cfg)
+	overflow := parseTitleOverflow(cfg.TitleOverflow)
+
+	l := newVideoList("YouTube Subscriptions", overflow)
 
 	// Add custom keybindings for subscription management and video playback
 	l.AdditionalFullHelpKeys = func() []key.Binding {
@@ -186,35 +408,239 @@ func NewModel(client *youtube.Client) Model {
 			),
 			key.NewBinding(
 				key.WithKeys("enter"),
-				key.WithHelp("enter", "play video"),
+				key.WithHelp("enter", enterHelp()),
 			),
 			key.NewBinding(
-				key.WithKeys("r"),
-				key.WithHelp("r", "reload videos"),
+				key.WithKeys(cfg.SoftRefreshKeyOrDefault()),
+				key.WithHelp(cfg.SoftRefreshKeyOrDefault(), "soft refresh (new uploads only)"),
 			),
 			key.NewBinding(
-				key.WithKeys("f"),
-				key.WithHelp("f", "force reload (clear cache)"),
+				key.WithKeys(cfg.HardRefreshKeyOrDefault()),
+				key.WithHelp(cfg.HardRefreshKeyOrDefault(), "hard refresh (clear cache)"),
+			),
+			key.NewBinding(
+				key.WithKeys("C"),
+				key.WithHelp("C", "show cached videos (offline)"),
 			),
 			key.NewBinding(
 				key.WithKeys("c"),
 				key.WithHelp("c", "copy video URL"),
 			),
+			key.NewBinding(
+				key.WithKeys("Y"),
+				key.WithHelp("Y", "copy all shown URLs"),
+			),
+			key.NewBinding(
+				key.WithKeys("E"),
+				key.WithHelp("E", "export M3U playlist"),
+			),
+			key.NewBinding(
+				key.WithKeys("O"),
+				key.WithHelp("O", "create YouTube playlist"),
+			),
 			key.NewBinding(
 				key.WithKeys("D"),
 				key.WithHelp("D", "download video"),
 			),
+			key.NewBinding(
+				key.WithKeys("w"),
+				key.WithHelp("w", "open in browser"),
+			),
+			key.NewBinding(
+				key.WithKeys("R"),
+				key.WithHelp("R", "explore related videos"),
+			),
+			key.NewBinding(
+				key.WithKeys("p"),
+				key.WithHelp("p", "play a URL or video ID"),
+			),
+			key.NewBinding(
+				key.WithKeys("a"),
+				key.WithHelp("a", "add to queue"),
+			),
+			key.NewBinding(
+				key.WithKeys("P"),
+				key.WithHelp("P", "play queue"),
+			),
+			key.NewBinding(
+				key.WithKeys("o"),
+				key.WithHelp("o", "cycle sort order"),
+			),
+			key.NewBinding(
+				key.WithKeys("+", "-"),
+				key.WithHelp("+/-", "more/fewer videos per channel"),
+			),
+			key.NewBinding(
+				key.WithKeys("t"),
+				key.WithHelp("t", "cycle category"),
+			),
+			key.NewBinding(
+				key.WithKeys("i"),
+				key.WithHelp("i", "toggle smart feed"),
+			),
+			key.NewBinding(
+				key.WithKeys("S"),
+				key.WithHelp("S", shortsHelp(cfg.HideShorts)),
+			),
+			key.NewBinding(
+				key.WithKeys("U"),
+				key.WithHelp("U", "collapse re-uploads"),
+			),
+			key.NewBinding(
+				key.WithKeys("J"),
+				key.WithHelp("J", "resume channel"),
+			),
+			key.NewBinding(
+				key.WithKeys("e"),
+				key.WithHelp("e", "show channel load errors"),
+			),
+			key.NewBinding(
+				key.WithKeys("H"),
+				key.WithHelp("H", "play from chapter"),
+			),
+			key.NewBinding(
+				key.WithKeys("T"),
+				key.WithHelp("T", "cycle thumbnail size"),
+			),
+			key.NewBinding(
+				key.WithKeys("Z"),
+				key.WithHelp("Z", "undo watched change"),
+			),
+			key.NewBinding(
+				key.WithKeys("*"),
+				key.WithHelp("*", "star/unstar video"),
+			),
+			key.NewBinding(
+				key.WithKeys("F"),
+				key.WithHelp("F", "show favorites"),
+			),
+			key.NewBinding(
+				key.WithKeys("."),
+				key.WithHelp(".", "dismiss video"),
+			),
+			key.NewBinding(
+				key.WithKeys(","),
+				key.WithHelp(",", "show dismissed"),
+			),
+			key.NewBinding(
+				key.WithKeys("N"),
+				key.WithHelp("N", "fetch new only"),
+			),
+			key.NewBinding(
+				key.WithKeys("W"),
+				key.WithHelp("W", "watched style"),
+			),
+			key.NewBinding(
+				key.WithKeys("G"),
+				key.WithHelp("G", "toggle mpv fullscreen"),
+			),
+			key.NewBinding(
+				key.WithKeys("I"),
+				key.WithHelp("I", "show stats"),
+			),
+			key.NewBinding(
+				key.WithKeys("K"),
+				key.WithHelp("K", "manage caches"),
+			),
+			key.NewBinding(
+				key.WithKeys("V"),
+				key.WithHelp("V", "switch play profile"),
+			),
+			key.NewBinding(
+				key.WithKeys("M"),
+				key.WithHelp("M", "manage players"),
+			),
+			key.NewBinding(
+				key.WithKeys("L"),
+				key.WithHelp("L", "view log"),
+			),
+		}
+	}
+
+	related := newVideoList("Related videos", overflow)
+	related.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "play video"),
+			),
+			key.NewBinding(
+				key.WithKeys("b", "esc"),
+				key.WithHelp("b/esc", "back to feed"),
+			),
+		}
+	}
+
+	favorites := newVideoList("Favorites", overflow)
+	favorites.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "play video"),
+			),
+			key.NewBinding(
+				key.WithKeys("*"),
+				key.WithHelp("*", "unstar video"),
+			),
+			key.NewBinding(
+				key.WithKeys("b", "esc"),
+				key.WithHelp("b/esc", "back to feed"),
+			),
 		}
 	}
 
-	return Model{
+	dismissedList := newVideoList("Dismissed videos", overflow)
+	dismissedList.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "play video"),
+			),
+			key.NewBinding(
+				key.WithKeys(".", "u"),
+				key.WithHelp("./u", "restore to feed"),
+			),
+			key.NewBinding(
+				key.WithKeys("b", "esc"),
+				key.WithHelp("b/esc", "back to feed"),
+			),
+		}
+	}
+
+	latestOnly := make(map[string]bool, len(cfg.LatestOnlyChannels))
+	for _, channelID := range cfg.LatestOnlyChannels {
+		latestOnly[channelID] = true
+	}
+
+	m := Model{
 		list:         l,
+		latestOnly:   latestOnly,
+		smartFeed:    cfg.SmartFeed,
+		collapseReuploads: cfg.CollapseReuploads,
+		restoreSelection: cfg.LastSelected != nil,
+		thumbnailSize: thumbnailSize(cfg.ThumbnailSize),
+		thumbnails:   newThumbnailCache(),
+		confirmOnExit: make(map[string]bool),
+		downloads:    make(map[string]float64),
+		related:      related,
+		favorites:    favorites,
+		dismissedList: dismissedList,
+		playURLInput: newPlayURLInput(),
+		mpvCommandInput: newMPVCommandInput(),
 		youtubeClient: client,
+		cfg:          cfg,
+		clock:        client.Clock(),
+		titleOverflow: overflow,
 		loading:      true,
 		spinner:      s,
 		notification: "",
 		notificationTimer: 0,
 	}
+	m.list.Title = m.feedTitle()
+	m.showMaxVideos()
+	m.usePageLabels()
+	setWatchedDisplay(cfg.WatchedStyle)
+	return m
 }
 
 // Init initializes the model
@@ -225,16 +651,52 @@ func (m Model) Init() tea.Cmd {
 	)
 }
 
+// startupWarning shows a warning about the configuration once the UI is up
+func (m Model) startupWarning() tea.Cmd {
+	var warnings []string
+	
+	// Say up front that settings and subscription changes won't stick
+	var readOnly *config.ReadOnlyError
+	if err := config.CheckWritable(); errors.As(err, &readOnly) {
+		warnings = append(warnings, readOnly.Error())
+	}
+	if warning := m.youtubeClient.SponsorBlockWarning(); warning != "" {
+		warnings = append(warnings, warning)
+	}
+	if len(warnings) == 0 {
+		return nil
+	}
+	warning := strings.Join(warnings, ". ")
+	slog.Warn(warning)
+	return func() tea.Msg {
+		return warningMsg{message: warning}
+	}
+}
+
 // fetchVideos fetches videos from YouTube
 func (m Model) fetchVideos() tea.Cmd {
 	return func() tea.Msg {
 		// Get videos from the YouTube client
-		videos, err := m.youtubeClient.GetLatestVideos()
+		result, err := m.youtubeClient.GetLatestVideos()
 		if err != nil {
 			return errMsg{err}
 		}
-		
-		return videosMsg{videos}
+		return m.loadedVideos(result)
+	}
+}
+
+// loadedVideos turns a fetch result into the message that shows it
+func (m Model) loadedVideos(result youtube.FetchResult) videosMsg {
+	// Remember when each video first showed up, failing that just means no NEW badges
+	seen, _ := m.youtubeClient.RecordSeen(result.Videos)
+	
+	return videosMsg{
+		videos:              result.Videos,
+		seen:                seen,
+		failed:              result.Errors,
+		noUploads:           result.NoUploads,
+		quotaExhaustedUntil: result.QuotaExhaustedUntil,
+		offline:             result.Offline,
 	}
 }
 
@@ -246,28 +708,229 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		m.list.SetSize(msg.Width, msg.Height-4)
+		m.resizeList()
+		m.related.SetSize(msg.Width, msg.Height-4)
+		m.favorites.SetSize(msg.Width, msg.Height-4)
+		m.dismissedList.SetSize(msg.Width, msg.Height-4)
+		m.resizeTranscript()
+
+	case tea.MouseMsg:
+		return m.updateMouse(msg)
 
 	case tea.KeyMsg:
+		// While playing an arbitrary URL, keys go to the form
+		if m.playURLMode || m.playedVideo != nil {
+			return m.updatePlayURL(msg)
+		}
+		
+		// While reading a transcript, keys scroll it
+		if m.transcriptVideo != nil {
+			return m.updateTranscript(msg)
+		}
+		
+		// While the chapter picker is open, keys choose a chapter
+		if m.chapterVideo != nil {
+			return m.updateChapters(msg)
+		}
+		
+		// Choose the quality before streaming on a metered connection
+		if m.confirmPlay != nil {
+			return m.updateConfirmPlay(msg)
+		}
+		
+		// While the MPV command is shown, keys play or edit it
+		if m.mpvCommandVideo != nil {
+			return m.updateMPVCommand(msg)
+		}
+		
+		// While exploring related videos, keys apply to the related list
+		if m.showRelated {
+			return m.updateRelated(msg)
+		}
+		
+		// While the error details panel is open, only allow closing it
+		if m.showErrors {
+			switch msg.String() {
+			case "q", "ctrl+c":
+				return m, tea.Quit
+			case "e", "esc":
+				m.showErrors = false
+			}
+			return m, nil
+		}
+
+		// While the stats screen is open, only allow closing it
+		if m.showStats {
+			switch msg.String() {
+			case "q", "ctrl+c":
+				return m, tea.Quit
+			case "I", "esc":
+				m.showStats = false
+			}
+			return m, nil
+		}
+
+		// While the cache maintenance panel is open, keys clear caches
+		if m.showCaches {
+			return m.updateCaches(msg)
+		}
+
+		// While the running players are listed, keys select and stop them
+		if m.showPlayers {
+			return m.updatePlayers(msg)
+		}
+
+		// Answer a pending "mark as watched?" prompt
+		if m.confirmWatched != nil {
+			switch msg.String() {
+			case "y":
+				videoID := m.confirmWatched.ID
+				m.confirmWatched = nil
+				return m, m.markWatched(videoID)
+			case "n", "esc":
+				m.confirmWatched = nil
+				return m, nil
+			case "ctrl+c":
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		
+		// While browsing dismissed videos, keys apply to the dismissed list
+		if m.showDismissed {
+			return m.updateDismissed(msg)
+		}
+		
+		// While browsing favorites, keys apply to the favorites list
+		if m.showFavorites {
+			return m.updateFavorites(msg)
+		}
+
 		switch {
 		case key.Matches(msg, key.NewBinding(key.WithKeys("q", "ctrl+c"))):
 			return m, tea.Quit
 
+		case key.Matches(msg, key.NewBinding(key.WithKeys("W"))):
+			// Cycle how watched videos are marked in the list
+			return m.cycleWatchedDisplay()
+			
+		case key.Matches(msg, key.NewBinding(key.WithKeys("G"))):
+			// Toggle whether MPV opens fullscreen
+			return m.toggleFullscreen()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("S"))):
+			// Hide or show Shorts from the videos already fetched
+			return m.toggleShorts()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("T"))):
+			// Cycle the thumbnail preview size
+			return m.cycleThumbnailSize()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("H"))):
+			// Pick a chapter of the current video to start playing from
+			if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+				return m.openChapters(selectedItem.video)
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("X"))):
+			// Read the current video's captions instead of watching it
+			if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+				return m.openTranscript(selectedItem.video)
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("Z"))):
+			// Undo the last watched change
+			return m.undoWatched()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("*"))):
+			// Star or unstar the current video
+			if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+				return m, m.toggleStar(selectedItem.video)
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("."))):
+			// Hide the current video from the feed without marking it watched
+			if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+				return m, m.dismissVideo(selectedItem.video, true)
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys(","))):
+			// Browse the dismissed videos to restore them
+			return m, m.openDismissed()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("F"))):
+			// Browse the favorite videos
+			return m, m.openFavorites()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("I"))):
+			// Show the stats screen once the overview is computed
+			return m, m.loadStats()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("V"))):
+			// Switch between streaming presets, e.g. home and mobile
+			return m.cyclePlayProfile()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("M"))):
+			// List the videos playing in the background
+			return m.openPlayers()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("!"))):
+			// Show the exact MPV command playing the current video would run
+			if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+				return m.openMPVCommand(selectedItem.video)
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("K"))):
+			// Show what is cached, with options to clear it
+			return m.openCaches()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("e"))):
+			// Show details for channels that failed to load
+			if len(m.fetchErrors) > 0 || len(m.noUploads) > 0 {
+				m.showErrors = true
+				return m, nil
+			}
+
 		case key.Matches(msg, key.NewBinding(key.WithKeys("s"))):
 			// Switch to subscription manager
-			subModel := NewSubscriptionModel(m.youtubeClient)
+			subModel := NewSubscriptionModel(m.youtubeClient, m.cfg)
 			return subModel, subModel.Init()
 
-		case key.Matches(msg, key.NewBinding(key.WithKeys("r"))):
-			// Regular reload (uses cache if valid)
-			m.loading = true
-			return m, tea.Batch(
-				m.spinner.Tick,
-				m.fetchVideos(),
-			)
+		case key.Matches(msg, key.NewBinding(key.WithKeys(m.softRefreshKey()))):
+			// Check the channel feeds and only fetch channels with new uploads,
+			// also retries after failing offline
+			return m.softRefresh()
+			
+		case key.Matches(msg, key.NewBinding(key.WithKeys(m.hardRefreshKey()))):
+			// Clear the cache and fetch every channel
+			return m.hardRefresh()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("N"))):
+			// Only add videos newer than the newest one shown, keeping the list as is
+			if !m.loading {
+				return m.fetchNewVideos()
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("+", "-"))):
+			// Fetch more or fewer videos per channel for this session
+			maxVideos := m.youtubeClient.MaxVideosPerChannel()
+			if msg.String() == "+" {
+				maxVideos += maxVideosStep
+			} else {
+				maxVideos -= maxVideosStep
+			}
+			if maxVideos < 1 {
+				maxVideos = 1
+			}
+			if maxVideos > maxVideosLimit {
+				maxVideos = maxVideosLimit
+			}
+			if maxVideos == m.youtubeClient.MaxVideosPerChannel() {
+				return m, nil
+			}
 			
-		case key.Matches(msg, key.NewBinding(key.WithKeys("f"))):
-			// Force reload (clear cache)
+			m.youtubeClient.SetMaxVideosPerChannel(maxVideos)
+			m.showMaxVideos()
 			m.youtubeClient.ClearVideoCache()
 			m.loading = true
 			return m, tea.Batch(
@@ -275,33 +938,86 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.fetchVideos(),
 			)
 
+		case key.Matches(msg, key.NewBinding(key.WithKeys("C"))):
+			// Show cached videos only, even if expired (no network)
+			result := m.youtubeClient.GetLatestVideosCachedOnly()
+			if len(result.Videos) == 0 {
+				m.notification = "No cached videos available"
+			} else {
+				m.notification = fmt.Sprintf("Showing %d cached videos", len(result.Videos))
+			}
+			m.notificationTimer = 3
+			return m, tea.Batch(
+				func() tea.Msg {
+					seen, _ := m.youtubeClient.RecordSeen(result.Videos)
+					return videosMsg{videos: result.Videos, seen: seen, failed: result.Errors, noUploads: result.NoUploads}
+				},
+				tea.Tick(time.Second, func(time.Time) tea.Msg {
+					return tickMsg{}
+				}),
+			)
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("o"))):
+			// Cycle the sort order
+			m.sortMode = m.sortMode.next()
+			m.setVideoItems()
+			m.notification = "Sort: " + m.sortMode.String()
+			m.notificationTimer = 3
+			return m, tea.Tick(time.Second, func(time.Time) tea.Msg {
+				return tickMsg{}
+			})
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("t"))):
+			// Cycle the category filter
+			if len(m.cfg.Categories) == 0 {
+				return m.notify("No categories yet, press c in the subscription manager to add one")
+			}
+			m.category = nextCategory(m.cfg.Categories, m.category)
+			m.list.Title = m.feedTitle()
+			m.setVideoItems()
+			if m.category == "" {
+				return m.notify("Category: all")
+			}
+			return m.notify("Category: " + m.category)
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("i"))):
+			// Toggle the smart feed
+			m.smartFeed = !m.smartFeed
+			m.setVideoItems()
+			if m.smartFeed {
+				m.notification = "Smart feed: only videos newer than your last watched per channel"
+			} else {
+				m.notification = "Smart feed off"
+			}
+			m.notificationTimer = 3
+			return m, tea.Tick(time.Second, func(time.Time) tea.Msg {
+				return tickMsg{}
+			})
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("J"))):
+			// Jump to where catching up on the selected video's channel left off
+			return m, m.resumeChannel()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("U"))):
+			// Toggle hiding videos their channel re-uploaded, a title heuristic
+			m.collapseReuploads = !m.collapseReuploads
+			m.setVideoItems()
+			if !m.collapseReuploads {
+				return m.notify("Showing re-uploaded videos")
+			}
+			return m.notify(fmt.Sprintf("Collapsed %d re-upload%s", len(m.reuploadOf), pluralize(len(m.reuploadOf))))
+
 		case key.Matches(msg, key.NewBinding(key.WithKeys("enter"))):
-			if m.list.SelectedItem() != nil {
-				selectedItem := m.list.SelectedItem().(Item)
-				
-				// Show notification immediately
-				m.notification = "Launching video..."
-				m.notificationTimer = 3
-				
-				return m, tea.Batch(
-					func() tea.Msg {
-						// Mark the video as watched before playing it
-						err := m.youtubeClient.MarkVideoAsWatched(selectedItem.video.ID)
-						if err != nil {
-							return errMsg{err}
-						}
-						
-						err = m.youtubeClient.PlayVideo(selectedItem.video.ID)
-						if err != nil {
-							return errMsg{err}
-						}
-						return videoWatchedMsg{videoID: selectedItem.video.ID}
-					},
-					tea.Tick(time.Second, func(time.Time) tea.Msg {
-						return tickMsg{}
-					}),
-				)
+			if selectedItem, ok := m.list.SelectedItem().(Item); ok && pickMode {
+				// Hand the video to the shell pipeline that launched us
+				m.picked = &selectedItem.video
+				return m, tea.Quit
 			}
+			if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+				m.clearFilterOffered = false
+				return m.requestStream(selectedItem.video)
+			}
+			return m.noSelection()
 
 		case key.Matches(msg, key.NewBinding(key.WithKeys("c"))):
 			if m.list.SelectedItem() != nil {
@@ -317,52 +1033,276 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				)
 			}
 
+		case key.Matches(msg, key.NewBinding(key.WithKeys("Y"))):
+			// Copy the URLs of every video shown, respecting the active filter
+			var videoIDs []string
+			for _, listItem := range m.list.VisibleItems() {
+				if videoItem, ok := listItem.(Item); ok {
+					videoIDs = append(videoIDs, videoItem.video.ID)
+				}
+			}
+			if len(videoIDs) == 0 {
+				return m, nil
+			}
+			return m, func() tea.Msg {
+				err := m.youtubeClient.CopyVideoURLsToClipboard(videoIDs)
+				if err != nil {
+					return errMsg{err}
+				}
+				return clipboardMsg{message: fmt.Sprintf("%d URLs copied to clipboard", len(videoIDs))}
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("E"))):
+			return m, m.exportM3U()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("O"))):
+			return m.createPlaylist()
+
 		case key.Matches(msg, key.NewBinding(key.WithKeys("D"))):
 			if m.list.SelectedItem() != nil {
 				selectedItem := m.list.SelectedItem().(Item)
-				return m, tea.Batch(
-					func() tea.Msg {
-						err := m.youtubeClient.DownloadVideo(selectedItem.video.ID)
-						if err != nil {
-							return errMsg{err}
-						}
-						return downloadMsg{message: "Video downloaded successfully"}
-					},
-				)
+				return m.startDownload(selectedItem.video)
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("p"))):
+			// Play a video from a pasted URL or ID
+			m.playURLMode = true
+			m.playURLError = ""
+			m.playURLInput.Reset()
+			return m, m.playURLInput.Focus()
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("R"))):
+			// Explore videos related to the highlighted one
+			if m.list.SelectedItem() != nil {
+				selectedItem := m.list.SelectedItem().(Item)
+				m.showRelated = true
+				m.relatedLoading = true
+				m.related.Title = "Related to: " + selectedItem.video.Title
+				m.related.SetItems(nil)
+				return m, func() tea.Msg {
+					videos, err := m.youtubeClient.GetRelatedVideos(selectedItem.video.ID)
+					if err != nil {
+						return errMsg{err}
+					}
+					return relatedVideosMsg{videos: videos}
+				}
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("a"))):
+			// Add the highlighted video to the play queue
+			if m.list.SelectedItem() != nil {
+				return m.enqueue(m.list.SelectedItem().(Item).video)
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("P"))):
+			// Play the queue, one video after another
+			if !m.queuePlaying {
+				if len(m.queue) == 0 {
+					return m.notify("The queue is empty, press a to add videos")
+				}
+				return m.playNextInQueue()
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("x"))):
+			// Stop playing the queue after the current video
+			if m.queuePlaying {
+				return m.stopQueue()
+			}
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("w"))):
+			if m.list.SelectedItem() != nil {
+				selectedItem := m.list.SelectedItem().(Item)
+				return m, func() tea.Msg {
+					err := m.youtubeClient.OpenInBrowser(selectedItem.video.ID)
+					if err != nil {
+						return errMsg{err}
+					}
+					return playedMsg{
+						action:  actionBrowser,
+						video:   selectedItem.video,
+						message: "Opened in browser",
+					}
+				}
 			}
 		}
 
+	case settingNotSavedMsg:
+		return m.notify(msg.err.Error())
+
 	case videosMsg:
 		m.videos = msg.videos
+		m.fetchErrors = msg.failed
+		m.noUploads = msg.noUploads
+		m.seen = msg.seen
+		m.quotaExhaustedUntil = msg.quotaExhaustedUntil
+		m.offline = msg.offline
+		for _, failed := range msg.failed {
+			slog.Warn("channel failed to load", "channel", failed.ChannelID, "err", failed.Err)
+		}
 		m.loading = false
+		if msg.refresh != "" && msg.quotaExhaustedUntil.IsZero() && !msg.offline {
+			var notifyCmd tea.Cmd
+			m, notifyCmd = m.notify(msg.refresh)
+			cmds = append(cmds, notifyCmd)
+		}
 		
-		// Get watched videos
+		// Load the watched videos once, then build the list items from memory
 		watchedVideos, err := m.youtubeClient.GetWatchedVideos()
 		if err != nil {
 			m.err = err
-			m.loading = false
 			break
 		}
+		m.watched = watchedVideos
 		
-		// Convert videos to list items
-		items := make([]list.Item, len(m.videos))
-		for i, video := range m.videos {
-			// Check if this video is in the watched list
-			watched := watchedVideos[video.ID]
-			items[i] = Item{video: video, watched: watched}
+		// Keep the selection on the same video across reloads
+		var selectedID string
+		if selectedItem, ok := m.list.SelectedItem().(Item); ok {
+			selectedID = selectedItem.video.ID
 		}
 		
-		m.list.SetItems(items)
+		// A broken favorites file shouldn't hide the feed, it just loses the stars
+		if starred, err := m.loadStarred(); err == nil {
+			m.starred = starred
+		}
+		// Likewise a broken dismissed file just shows every video
+		if dismissed, err := m.loadDismissed(); err == nil {
+			m.dismissed = dismissed
+		}
+		m.setVideoItems()
+		if m.restoreSelection {
+			// Pick up where the last session left off, once
+			m.restoreSelection = false
+			m.restoreLastSelected()
+		} else {
+			m.selectVideo(selectedID)
+		}
+		
+		// Keep premiere countdowns current while any are in the list
+		if !m.countdownTicking && hasUpcoming(m.videos, m.clock.Now()) {
+			m.countdownTicking = true
+			cmds = append(cmds, countdownTick())
+		}
+
+	case countdownTickMsg:
+		if !hasUpcoming(m.videos, m.clock.Now()) {
+			m.countdownTicking = false
+			break
+		}
+		cmds = append(cmds, countdownTick())
+
+	case list.FilterMatchesMsg:
+		// Filter results belong to whichever list is showing
+		if m.showRelated {
+			var cmd tea.Cmd
+			m.related, cmd = m.related.Update(msg)
+			return m, cmd
+		}
+		if m.showDismissed {
+			var cmd tea.Cmd
+			m.dismissedList, cmd = m.dismissedList.Update(msg)
+			return m, cmd
+		}
+
+	case playURLVideoMsg:
+		return m.requestPlay(pendingPlay{video: msg.video, kind: playURL})
+
+	case arbitraryPlayedMsg:
+		// Offer to mark the video watched or subscribe to its channel
+		m.playedVideo = &msg.video
+
+	case channelProgressMsg:
+		return m.applyChannelProgress(msg)
+
+	case playerExitedMsg:
+		if m.showPlayers {
+			m.refreshPlayers()
+		}
+		m.confirmAfterPlayback(msg.video)
+		return m, nil
+
+	case thumbnailMsg:
+		if msg.image != nil {
+			m.thumbnails.put(msg.videoID, msg.image)
+		}
+		return m, nil
+
+	case transcriptMsg:
+		return m.applyTranscript(msg)
+
+	case chaptersMsg:
+		// Ignore chapters for a picker that has since been closed or reopened
+		if m.chapterVideo != nil && m.chapterVideo.ID == msg.videoID {
+			m.chapters = msg.chapters
+			m.chaptersLoading = false
+		}
+
+	case dismissedListMsg:
+		if len(msg.videos) == 0 {
+			return m.notify("No dismissed videos, press . to hide one from the feed")
+		}
+		m.setDismissedItems(msg.videos)
+		m.showDismissed = true
+
+	case newVideosMsg:
+		return m.applyNewVideos(msg)
+
+	case downloadProgressMsg:
+		return m.applyDownloadProgress(msg)
+
+	case downloadDoneMsg:
+		return m.finishDownload(msg)
+
+	case dismissedMsg:
+		return m.applyDismissed(msg)
+
+	case favoritesMsg:
+		m.setFavoriteItems(msg.videos)
+		m.showFavorites = true
+
+	case starredMsg:
+		m.setItemStarred(msg.video, msg.starred)
+
+	case statsMsg:
+		m.stats = msg.stats
+		m.showStats = true
+
+	case relatedVideosMsg:
+		m.relatedLoading = false
+		items := make([]list.Item, len(msg.videos))
+		for i, video := range msg.videos {
+			items[i] = m.videoItem(video)
+		}
+		m.related.SetItems(items)
 
 	case errMsg:
+		slog.Error("command failed", "err", msg.err)
+		m.fetchingNew = false
 		m.err = msg.err
 		m.loading = false
+		m.showRelated = false
+		m.chapterVideo = nil
 
 	case spinner.TickMsg:
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
 		cmds = append(cmds, cmd)
 
+	case autoRefreshMsg:
+		// Reload in the background, the cache decides whether the API is hit.
+		// Skip it if a load is already running.
+		if m.loading {
+			break
+		}
+		return m, m.fetchVideos()
+
+	case dailyRefreshMsg:
+		// The app model has already cleared the cache
+		m.loading = true
+		return m, tea.Batch(
+			m.spinner.Tick,
+			m.fetchVideos(),
+		)
+
 	case returnToMainMsg:
 		// Reset the model to loading state
 		m.loading = true
@@ -371,17 +1311,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.fetchVideos(),
 		)
 
-	case videoWatchedMsg:
-		// Update the watched status in the list
-		for i, item := range m.list.Items() {
-			videoItem, ok := item.(Item)
-			if ok && videoItem.video.ID == msg.videoID {
-				videoItem.watched = true
-				m.list.SetItem(i, videoItem)
-				break
-			}
+	case watchedChangedMsg:
+		// Update the watched status in the list and remember the change for undo
+		m.pushUndo(msg.change)
+		for _, id := range msg.change.IDs {
+			m.setItemWatched(id, msg.watched)
+			cmds = append(cmds, m.clearNew(id))
 		}
 
+	case watchedUndoneMsg:
+		return m.applyWatchedUndo(msg.change)
+
 	case clipboardMsg:
 		m.notification = msg.message
 		m.notificationTimer = 3 // Show for 3 seconds
@@ -389,13 +1329,33 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return tickMsg{}
 		})
 
-	case downloadMsg:
+	case playedMsg:
+		// Mark as watched (or ask) according to the policy for this action
+		var cmd tea.Cmd
+		m, cmd = m.applyWatchedPolicy(msg.action, msg.video)
+		cmd = tea.Batch(cmd, m.clearNew(msg.video.ID))
+		if msg.message == "" {
+			return m, cmd
+		}
 		m.notification = msg.message
 		m.notificationTimer = 3 // Show for 3 seconds
+		return m, tea.Batch(cmd, tea.Tick(time.Second, func(time.Time) tea.Msg {
+			return tickMsg{}
+		}))
+
+	case warningMsg:
+		m.notification = msg.message
+		m.notificationTimer = 8
 		return m, tea.Tick(time.Second, func(time.Time) tea.Msg {
 			return tickMsg{}
 		})
 
+	case queueVideoDoneMsg:
+		return m.queueVideoDone(msg)
+
+	case queueCountdownMsg:
+		return m.updateQueueCountdown(msg)
+
 	case tickMsg:
 		if m.notificationTimer > 0 {
 			m.notificationTimer--
@@ -412,6 +1372,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	m.list, cmd = m.list.Update(msg)
 	cmds = append(cmds, cmd)
+	
+	// Fetch the thumbnail of whatever is now selected
+	cmds = append(cmds, m.loadSelectedThumbnail())
 
 	return m, tea.Batch(cmds...)
 }
@@ -421,7 +1384,21 @@ func (m Model) View() string {
 	// Create the base view first
 	var baseView string
 	
-	if m.err != nil {
+	if errors.Is(m.err, youtube.ErrOffline) {
+		baseView = lipgloss.Place(
+			m.width,
+			m.height,
+			lipgloss.Center,
+			lipgloss.Center,
+			lipgloss.JoinVertical(
+				lipgloss.Center,
+				"Offline, no cached data",
+				"",
+				"YouTube couldn't be reached and there are no cached videos to show yet.",
+				helpText("Press r to retry", "q to quit"),
+			),
+		)
+	} else if m.err != nil {
 		baseView = fmt.Sprintf("Error: %v\nPress q to quit.", m.err)
 	} else if m.loading {
 		baseView = lipgloss.Place(
@@ -431,13 +1408,119 @@ func (m Model) View() string {
 			lipgloss.Center,
 			lipgloss.JoinVertical(
 				lipgloss.Center,
-				m.spinner.View()+" Loading videos...",
+				m.spinner.View()+" "+m.cfg.LoadingVideosText,
 				"",
 				"Press q to quit",
 			),
 		)
+	} else if m.playURLMode || m.playedVideo != nil {
+		baseView = m.playURLView()
+	} else if m.confirmPlay != nil {
+		baseView = m.confirmPlayView()
+	} else if m.transcriptVideo != nil {
+		baseView = m.transcriptReaderView()
+	} else if m.mpvCommandVideo != nil {
+		baseView = m.mpvCommandView()
+	} else if m.chapterVideo != nil {
+		baseView = m.chaptersView()
+	} else if m.showDismissed && m.confirmWatched == nil {
+		baseView = m.dismissedList.View()
+	} else if m.showFavorites && m.confirmWatched == nil {
+		baseView = m.favorites.View()
+	} else if m.showRelated && m.relatedLoading {
+		baseView = lipgloss.Place(
+			m.width,
+			m.height,
+			lipgloss.Center,
+			lipgloss.Center,
+			m.spinner.View()+" Finding related videos...",
+		)
+	} else if m.showRelated && m.confirmWatched == nil {
+		baseView = m.related.View()
+	} else if m.confirmWatched != nil {
+		baseView = lipgloss.Place(
+			m.width,
+			m.height,
+			lipgloss.Center,
+			lipgloss.Center,
+			lipgloss.NewStyle().
+				BorderStyle(panelBorder()).
+				BorderForeground(lipgloss.Color("240")).
+				Padding(1).
+				Render("Mark as watched?\n\n"+
+					channelStyle.Render(m.confirmWatched.Title)+"\n\n"+
+					lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(helpText("y: yes", "n: no"))),
+		)
+	} else if m.showErrors {
+		baseView = m.errorDetailsView()
+	} else if m.showStats {
+		baseView = m.statsView()
+	} else if m.showCaches {
+		baseView = m.cachesView()
+	} else if m.showPlayers {
+		baseView = m.playersView()
 	} else {
+		// Only show the keys that apply right now below the list
+		shortHelp := m.shortHelpKeys()
+		m.list.AdditionalShortHelpKeys = func() []key.Binding { return shortHelp }
+		m.list.Paginator.ArabicFormat = m.pageLabel()
 		baseView = m.list.View()
+		
+		// Preview the selected video's thumbnail beside the list
+		if m.thumbnailSize != thumbnailOff {
+			baseView = lipgloss.JoinHorizontal(lipgloss.Top, baseView, "  ", m.thumbnailView())
+		}
+		
+		// Surface channels that failed to load below the list
+		if len(m.fetchErrors) > 0 {
+			warningStyle := lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#FFFDF5")).
+				Background(lipgloss.Color("#FF5F87")).
+				Padding(0, 1)
+			
+			channels := "channels"
+			if len(m.fetchErrors) == 1 {
+				channels = "channel"
+			}
+			warning := fmt.Sprintf("%d %s failed to load %s press e for details", len(m.fetchErrors), channels, glyph("—"))
+			baseView = baseView + "\n" + warningStyle.Render(warning)
+		}
+		
+		// Mention channels that loaded fine but are empty, so they aren't mistaken for failures
+		if len(m.noUploads) > 0 && len(m.fetchErrors) == 0 {
+			noUploads := fmt.Sprintf("%d channel%s with no uploads yet %s press e for details", len(m.noUploads), pluralize(len(m.noUploads)), glyph("—"))
+			baseView = baseView + "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(noUploads)
+		}
+		
+		// Explain why the feed is stale while offline
+		if m.offline {
+			offlineStyle := lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#FFFDF5")).
+				Background(lipgloss.Color("#FF8700")).
+				Padding(0, 1)
+			baseView = baseView + "\n" + offlineStyle.Render("No network connection " + glyph("—") + " showing cached data, press r to retry")
+		}
+		
+		// Explain why the feed isn't refreshing while the quota is exhausted
+		if !m.quotaExhaustedUntil.IsZero() {
+			quotaStyle := lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#FFFDF5")).
+				Background(lipgloss.Color("#FF8700")).
+				Padding(0, 1)
+			quota := fmt.Sprintf("API quota exhausted %s showing cached videos until it resets at %s",
+				glyph("—"), m.quotaExhaustedUntil.Local().Format("Jan 2 15:04"))
+			baseView = baseView + "\n" + quotaStyle.Render(quota)
+		}
+		
+		// Show the queue's progress and countdown below the list
+		if status := m.queueStatusView(); status != "" {
+			baseView = baseView + "\n" + status
+		}
+		
+		// Count the videos playing in the background
+		if status := m.playersStatusView(); status != "" {
+			baseView = baseView + "\n" + status
+		}
 	}
 	
 	// Add notification as a floating overlay if present
@@ -473,9 +1556,200 @@ func (m Model) View() string {
 	return baseView
 }
 
+// capturingInput reports whether keys are currently going to a text input or
+// an open prompt or panel, so the view switching keys must leave them alone
+func (m Model) capturingInput() bool {
+	return m.playURLMode || m.playedVideo != nil || m.transcriptVideo != nil || m.chapterVideo != nil ||
+		m.confirmPlay != nil || m.mpvCommandVideo != nil || m.confirmWatched != nil ||
+		m.showErrors || m.showStats || m.showCaches || m.showPlayers ||
+		m.list.FilterState() == list.Filtering ||
+		(m.showRelated && m.related.FilterState() == list.Filtering) ||
+		(m.showFavorites && m.favorites.FilterState() == list.Filtering) ||
+		(m.showDismissed && m.dismissedList.FilterState() == list.Filtering)
+}
+
+// updateRelated handles keys while the related videos explorer is open
+func (m Model) updateRelated(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Let the related list handle keys while filtering
+	if m.related.FilterState() == list.Filtering {
+		var cmd tea.Cmd
+		m.related, cmd = m.related.Update(msg)
+		return m, cmd
+	}
+	
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+		
+	case "b", "esc":
+		if m.related.FilterState() == list.FilterApplied {
+			m.related.ResetFilter()
+			return m, nil
+		}
+		m.showRelated = false
+		return m, nil
+		
+	case "enter":
+		if m.relatedLoading || m.related.SelectedItem() == nil {
+			return m, nil
+		}
+		selectedItem := m.related.SelectedItem().(Item)
+		return m.requestStream(selectedItem.video)
+	}
+	
+	var cmd tea.Cmd
+	m.related, cmd = m.related.Update(msg)
+	return m, cmd
+}
+
+// setVideoItems rebuilds the list items from m.videos using the current sort mode
+func (m *Model) setVideoItems() {
+	videos := m.filterVideos(m.videos)
+	m.reuploadOf = nil
+	if m.collapseReuploads {
+		videos, m.reuploadOf = collapseReuploads(videos)
+	}
+	videos = sortVideos(videos, m.sortMode, m.clock.Now())
+	if m.sortMode == sortByDate && m.cfg.PersonalizedRanking {
+		videos = m.personalizeRanking(videos)
+	}
+	items := make([]list.Item, len(videos))
+	m.itemIndex = make(map[string]int, len(videos))
+	for i, video := range videos {
+		items[i] = m.videoItem(video)
+		m.itemIndex[video.ID] = i
+	}
+	
+	m.list.SetItems(items)
+}
+
+// selectVideo moves the selection to the video, if it is shown
+func (m *Model) selectVideo(videoID string) {
+	if videoID == "" {
+		return
+	}
+	for i, listItem := range m.list.VisibleItems() {
+		if videoItem, ok := listItem.(Item); ok && videoItem.video.ID == videoID {
+			m.list.Select(i)
+			return
+		}
+	}
+}
+
+// setItemWatched updates the watched state of a single list item in place
+func (m *Model) setItemWatched(videoID string, watched bool) {
+	if m.watched == nil {
+		m.watched = make(map[string]bool)
+	}
+	m.watched[videoID] = watched
+	
+	// Watched state decides what the smart feed shows, so rebuild the list
+	if m.smartFeed {
+		m.setVideoItems()
+		return
+	}
+	
+	i, ok := m.itemIndex[videoID]
+	if !ok || i >= len(m.list.Items()) {
+		return
+	}
+	if videoItem, ok := m.list.Items()[i].(Item); ok && videoItem.video.ID == videoID {
+		videoItem.watched = watched
+		m.list.SetItem(i, videoItem)
+	}
+}
+
+// hasUpcoming reports whether any of the videos is a premiere still upcoming at now
+func hasUpcoming(videos []youtube.Video, now time.Time) bool {
+	for _, video := range videos {
+		if video.IsUpcoming(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// countdownTick schedules the next refresh of premiere countdowns
+func countdownTick() tea.Cmd {
+	return tea.Tick(30*time.Second, func(time.Time) tea.Msg {
+		return countdownTickMsg{}
+	})
+}
+
+// errorDetailsView renders the list of channels that failed to load
+func (m Model) errorDetailsView() string {
+	var sb strings.Builder
+	
+	if len(m.fetchErrors) > 0 {
+		title := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("205")).
+			Render("Channels that failed to load")
+		
+		sb.WriteString(title)
+		sb.WriteString("\n\n")
+		
+		for _, fetchErr := range m.fetchErrors {
+			sb.WriteString(channelStyle.Render(fetchErr.ChannelName))
+			sb.WriteString("\n")
+			sb.WriteString(lipgloss.NewStyle().
+				Foreground(lipgloss.Color("9")).
+				Render("  " + fetchErr.Err.Error()))
+			sb.WriteString("\n")
+		}
+	}
+	
+	// Channels without uploads loaded fine, list them separately
+	if len(m.noUploads) > 0 {
+		if len(m.fetchErrors) > 0 {
+			sb.WriteString("\n")
+		}
+		names := make([]string, 0, len(m.noUploads))
+		for _, name := range m.noUploads {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		
+		sb.WriteString(lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("205")).
+			Render("Channels with no uploads yet"))
+		sb.WriteString("\n\n")
+		for _, name := range names {
+			sb.WriteString(channelStyle.Render(name))
+			sb.WriteString("\n")
+			sb.WriteString(lipgloss.NewStyle().
+				Foreground(lipgloss.Color("240")).
+				Render("  This channel has no uploads yet"))
+			sb.WriteString("\n")
+		}
+	}
+	
+	help := "\n" + helpText("e/esc: close", "q: quit")
+	sb.WriteString(lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Render(help))
+	
+	return lipgloss.NewStyle().
+		BorderStyle(panelBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(1).
+		Render(sb.String())
+}
+
 // Message types
 type videosMsg struct {
 	videos []youtube.Video
+	failed []youtube.ChannelError
+	noUploads map[string]string
+	seen   map[string]youtube.SeenVideo
+	quotaExhaustedUntil time.Time // Set when the videos came from the cache because the quota is exhausted
+	offline bool // Set when the videos came from the cache because YouTube couldn't be reached
+	refresh string // Describes the refresh that loaded the videos, empty for a regular load
+}
+
+type warningMsg struct {
+	message string
 }
 
 type errMsg struct {
@@ -498,11 +1772,6 @@ func (m Model) ReturnFromSubscriptions() tea.Cmd {
 	)
 }
 
-// Add a new message type for when a video is watched
-type videoWatchedMsg struct {
-	videoID string
-}
-
 // Add a new message type for clipboard operations
 type clipboardMsg struct {
 	message string
@@ -511,7 +1780,33 @@ type clipboardMsg struct {
 // Add a new message type for timer ticks
 type tickMsg struct{}
 
-// Add a new message type for download operations
-type downloadMsg struct {
+// dailyRefreshMsg triggers the scheduled daily refresh
+type dailyRefreshMsg struct{}
+
+// autoRefreshMsg triggers a periodic reload of the feed
+type autoRefreshMsg struct{}
+
+// playURLVideoMsg carries the video of a pasted URL, looked up to be played
+type playURLVideoMsg struct {
+	video youtube.Video
+}
+
+// arbitraryPlayedMsg is sent after a video from a pasted URL has been launched
+type arbitraryPlayedMsg struct {
+	video youtube.Video
+}
+
+// relatedVideosMsg carries the results of a related videos lookup
+type relatedVideosMsg struct {
+	videos []youtube.Video
+}
+
+// countdownTickMsg refreshes premiere countdowns periodically
+type countdownTickMsg struct{}
+
+// playedMsg is sent after a video has been played, downloaded or opened
+type playedMsg struct {
+	action  string
+	video   youtube.Video
 	message string
 }
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// maxUndo is how many watched changes are kept for undo in a session
+const maxUndo = 50
+
+// watchedChangedMsg reports that videos were marked or unmarked as watched
+type watchedChangedMsg struct {
+	change  youtube.WatchedChange
+	watched bool
+}
+
+// watchedUndoneMsg reports that a watched change was reverted
+type watchedUndoneMsg struct {
+	change youtube.WatchedChange
+}
+
+// pushUndo records a watched change, dropping the oldest past maxUndo
+func (m *Model) pushUndo(change youtube.WatchedChange) {
+	m.undoStack = append(m.undoStack, change)
+	if len(m.undoStack) > maxUndo {
+		m.undoStack = m.undoStack[len(m.undoStack)-maxUndo:]
+	}
+}
+
+// undoWatched reverts the most recent watched change
+func (m Model) undoWatched() (Model, tea.Cmd) {
+	if len(m.undoStack) == 0 {
+		return m.notify("Nothing to undo")
+	}
+
+	change := m.undoStack[len(m.undoStack)-1]
+	m.undoStack = m.undoStack[:len(m.undoStack)-1]
+	return m, func() tea.Msg {
+		if err := m.youtubeClient.UndoWatchedChange(change); err != nil {
+			return errMsg{err}
+		}
+		return watchedUndoneMsg{change: change}
+	}
+}
+
+// applyWatchedUndo restores the list items of an undone change
+func (m Model) applyWatchedUndo(change youtube.WatchedChange) (Model, tea.Cmd) {
+	for _, id := range change.IDs {
+		_, wasWatched := change.Previous[id]
+		m.setItemWatched(id, wasWatched)
+	}
+	return m.notify(fmt.Sprintf("Undid watched change for %d video%s", len(change.IDs), pluralize(len(change.IDs))))
+}
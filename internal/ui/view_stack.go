@@ -0,0 +1,75 @@
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// Views the app switches between
+const (
+	viewVideos        = "videos"
+	viewSubscriptions = "subscriptions"
+	viewLogs          = "logs"
+)
+
+// currentView returns the view on screen
+func (m AppModel) currentView() string {
+	return m.views[len(m.views)-1]
+}
+
+// modelView returns the topmost view backed by a model, which receives the
+// messages the app doesn't handle itself. The log view only reads a file.
+func (m AppModel) modelView() string {
+	for i := len(m.views) - 1; i >= 0; i-- {
+		if m.views[i] != viewLogs {
+			return m.views[i]
+		}
+	}
+	return viewVideos
+}
+
+// pushView opens a view on top of the current one, remembering where to go back to
+func (m AppModel) pushView(view string) (AppModel, tea.Cmd) {
+	m.views = append(m.views, view)
+	return m, m.enterView(view)
+}
+
+// popView goes back to the previous view. The feed is always at the bottom,
+// so going back from it does nothing.
+func (m AppModel) popView() (AppModel, tea.Cmd) {
+	if len(m.views) == 1 {
+		return m, nil
+	}
+	left := m.currentView()
+	m.views = m.views[:len(m.views)-1]
+
+	if left == viewSubscriptions {
+		// Stop loading subscriptions, and reload the view below in case
+		// they changed
+		m.subModel.CancelLoading()
+		return m, m.enterView(m.currentView())
+	}
+	return m, nil
+}
+
+// enterView prepares a view that is about to be shown
+func (m *AppModel) enterView(view string) tea.Cmd {
+	switch view {
+	case viewVideos:
+		return m.videoModel.Init()
+	case viewSubscriptions:
+		return m.subModel.Init()
+	case viewLogs:
+		m.logView = newLogViewport(m.width, m.height)
+	}
+	return nil
+}
+
+// capturingInput reports whether the view on screen is taking text input,
+// so keys like b are typed instead of navigating
+func (m AppModel) capturingInput() bool {
+	switch m.currentView() {
+	case viewVideos:
+		return m.videoModel.capturingInput()
+	case viewSubscriptions:
+		return m.subModel.capturingInput()
+	}
+	return false
+}
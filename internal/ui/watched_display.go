@@ -0,0 +1,69 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// watchedDisplay is how watched videos stand out in the list
+type watchedDisplay string
+
+const (
+	watchedCheck  watchedDisplay = "check"  // A gray ✓ after the title
+	watchedDim    watchedDisplay = "dim"    // The whole title grayed out
+	watchedStrike watchedDisplay = "strike" // The title struck through
+	watchedPrefix watchedDisplay = "prefix" // "[seen]" before the title
+)
+
+// watchedDisplays is the order the styles are cycled through
+var watchedDisplays = []watchedDisplay{watchedCheck, watchedDim, watchedStrike, watchedPrefix}
+
+// watchedDisplayStyle is the active watched style, shared by every video list
+var watchedDisplayStyle = watchedCheck
+
+// next returns the style that follows d in the cycle
+func (d watchedDisplay) next() watchedDisplay {
+	for i, display := range watchedDisplays {
+		if display == d {
+			return watchedDisplays[(i+1)%len(watchedDisplays)]
+		}
+	}
+	return watchedCheck
+}
+
+// setWatchedDisplay sets the watched style from the config value
+func setWatchedDisplay(name string) {
+	watchedDisplayStyle = watchedCheck
+	if name != "" {
+		watchedDisplayStyle = watchedDisplay(name)
+	}
+}
+
+// watchedTitle renders a watched video's title in the active watched style,
+// starting from the style the title would have otherwise. The check style
+// leaves the title alone, the ✓ is added after the other markers.
+func watchedTitle(title string, style lipgloss.Style) string {
+	switch watchedDisplayStyle {
+	case watchedDim:
+		return style.Faint(true).Foreground(lipgloss.Color("#888888")).Render(title)
+	case watchedStrike:
+		return style.Strikethrough(true).Render(title)
+	case watchedPrefix:
+		return watchedStyle.Render("[seen]") + " " + style.Render(title)
+	default:
+		return style.Render(title)
+	}
+}
+
+// cycleWatchedDisplay switches to the next watched style and saves it to the config
+func (m Model) cycleWatchedDisplay() (Model, tea.Cmd) {
+	watchedDisplayStyle = watchedDisplayStyle.next()
+	m.cfg.WatchedStyle = string(watchedDisplayStyle)
+
+	style := m.cfg.WatchedStyle
+	m, notifyCmd := m.notify("Watched videos: " + style)
+	return m, tea.Batch(
+		notifyCmd,
+		saveSetting("watched_style", style),
+	)
+}
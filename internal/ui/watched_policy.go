@@ -0,0 +1,79 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/fabean/ytviewer/internal/youtube"
+)
+
+// Play actions that may mark a video as watched
+const (
+	actionStream   = "stream"
+	actionDownload = "download"
+	actionBrowser  = "browser"
+)
+
+// Watched policies for a play action
+const (
+	markWatchedYes = "yes"
+	markWatchedNo  = "no"
+	markWatchedAsk = "ask"
+)
+
+// shouldMarkWatched returns whether a play action marks the video as watched:
+// "yes", "no", or "ask" to prompt the user
+func (m Model) shouldMarkWatched(action string) string {
+	switch policy := m.cfg.MarkWatched[action]; policy {
+	case markWatchedYes, markWatchedNo, markWatchedAsk:
+		return policy
+	default:
+		return markWatchedNo
+	}
+}
+
+// applyWatchedPolicy marks the video as watched after a play action,
+// or asks first, according to the configured policy
+func (m Model) applyWatchedPolicy(action string, video youtube.Video) (Model, tea.Cmd) {
+	// Ask once the player exits instead, whatever the stream policy
+	if action == actionStream && m.cfg.ConfirmMarkWatched {
+		m.confirmOnExit[video.ID] = true
+		return m, nil
+	}
+
+	switch m.shouldMarkWatched(action) {
+	case markWatchedYes:
+		return m, m.markWatched(video.ID)
+	case markWatchedAsk:
+		m.confirmWatched = &video
+	}
+	return m, nil
+}
+
+// confirmAfterPlayback asks whether to mark a streamed video as watched once
+// its player exits, when confirm_mark_watched is set. Videos already watched,
+// or exiting while another answer is pending, aren't asked about.
+func (m *Model) confirmAfterPlayback(video youtube.Video) {
+	if !m.confirmOnExit[video.ID] {
+		return
+	}
+	delete(m.confirmOnExit, video.ID)
+	if m.confirmWatched == nil && !m.watched[video.ID] {
+		m.confirmWatched = &video
+	}
+}
+
+// markWatched marks a video as watched and updates its list item
+func (m Model) markWatched(videoID string) tea.Cmd {
+	return m.setWatched([]string{videoID}, true)
+}
+
+// setWatched marks or unmarks videos as watched, recording the change so it can be undone with Z
+func (m Model) setWatched(videoIDs []string, watched bool) tea.Cmd {
+	return func() tea.Msg {
+		change, err := m.youtubeClient.SetVideosWatched(videoIDs, watched)
+		if err != nil {
+			return errMsg{err}
+		}
+		return watchedChangedMsg{change: change, watched: watched}
+	}
+}
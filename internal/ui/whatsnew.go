@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// changelogEntry lists the features added in a config version
+type changelogEntry struct {
+	version  int
+	features []string
+}
+
+// changelog is shown once after an upgrade, newest version last
+var changelog = []changelogEntry{
+	{
+		version: 1,
+		features: []string{
+			"e: show details for channels that failed to load",
+			"C: show cached videos without touching the network",
+			"o: cycle sort order, including upcoming premieres first",
+			"z (subscriptions): snooze a channel for a few days",
+			"ytviewer cache dump / clear from the command line",
+			"search_channels, spinner_style and spinner_color config options",
+		},
+	},
+	{
+		version: 2,
+		features: []string{
+			"a / P: queue videos and play them in turn, x stops the queue",
+			"* / F: star videos and browse your favorites",
+			". / ,: dismiss videos from the feed and browse the dismissed ones",
+			"D: download with yt-dlp, H: play from a chapter, X: read the transcript",
+			"R: explore related videos, p: play any video by URL",
+			"S: hide Shorts, i: smart feed, U: collapse re-uploads, t: categories",
+			"V: switch play profiles, G: MPV fullscreen, !: show the MPV command",
+			"E / O: export the queue as an M3U or a YouTube playlist",
+			"I: stats, K: cache maintenance, M: running players, L: the log",
+			"r: soft refresh through RSS, N: fetch only newer videos, +/-: videos per channel",
+			"Subscriptions: quick-jump with /, v adds from the clipboard, D finds dead channels",
+			"--pick, --check-subs, --import-newpipe/--import-freetube, --import-history",
+			"player, oauth_client_id, watched_retention_days and more config options, see the README",
+		},
+	},
+}
+
+// changesSince returns the changelog entries newer than the given version
+func changesSince(version int) []changelogEntry {
+	var entries []changelogEntry
+	for _, entry := range changelog {
+		if entry.version > version {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// whatsNewView renders the "What's new" modal for the given changelog entries
+func whatsNewView(entries []changelogEntry, width, height int) string {
+	var sb strings.Builder
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("What's new in ytviewer")
+
+	sb.WriteString(title)
+	sb.WriteString("\n")
+
+	for _, entry := range entries {
+		sb.WriteString("\n")
+		sb.WriteString(channelStyle.Render(fmt.Sprintf("Version %d", entry.version)))
+		sb.WriteString("\n")
+		for _, feature := range entry.features {
+			sb.WriteString("  " + glyph("•") + " " + feature + "\n")
+		}
+	}
+
+	help := "\nPress any key to continue"
+	sb.WriteString(lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Render(help))
+
+	modal := lipgloss.NewStyle().
+		BorderStyle(panelBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(1).
+		Render(sb.String())
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, modal)
+}
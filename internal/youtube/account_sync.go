@@ -0,0 +1,126 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fabean/ytviewer/internal/config"
+	"google.golang.org/api/youtube/v3"
+)
+
+// accountSyncTimeout bounds listing the account's subscriptions, so a hung
+// connection doesn't hold up the launch
+const accountSyncTimeout = 30 * time.Second
+
+// SyncResult summarizes a sync of the subscriptions with the user's account
+type SyncResult struct {
+	Added int // Channels newly added to the subscriptions
+	Total int // Channels the account is subscribed to
+}
+
+// SetRemovedChannels sets the channels unsubscribed from in ytviewer, loaded from the config
+func (c *Client) SetRemovedChannels(ids []string) {
+	c.removedChannels = make(map[string]bool, len(ids))
+	for _, id := range ids {
+		c.removedChannels[id] = true
+	}
+}
+
+// recordRemoved remembers the channels unsubscribed from so the account sync
+// doesn't add them back, and forgets the ones subscribed to again
+func (c *Client) recordRemoved(added, removed []string) error {
+	ids, err := config.UpdateList("removed_channels", func(list []string) []string {
+		return applySubscriptionChange(list, removed, added)
+	})
+	if err != nil {
+		return err
+	}
+	c.SetRemovedChannels(ids)
+	return nil
+}
+
+// SyncSubscriptionsFromAccount adds the channels the user is subscribed to
+// on their YouTube account to the subscriptions, listing them 50 to a page
+// at 1 quota unit each. Channels only in the config are kept, so the config
+// list keeps working alongside the account, and channels unsubscribed from
+// in ytviewer aren't added back since nothing unsubscribes on YouTube.
+// Without OAuth credentials ErrOAuthNotConfigured is returned and the
+// subscriptions are left alone.
+func (c *Client) SyncSubscriptionsFromAccount() (SyncResult, error) {
+	var result SyncResult
+	if err := c.checkQuota(); err != nil {
+		return result, err
+	}
+
+	// Granting access the first time has its own timeout, as it waits on the browser
+	service, err := c.accountService(context.Background())
+	if err != nil {
+		return result, err
+	}
+	subscribed := make(map[string]bool, len(c.subscribedChannels))
+	for _, id := range c.subscribedChannels {
+		subscribed[id] = true
+	}
+
+	var added []string
+	subscribedAt := make(map[string]time.Time)
+	list := func(service *youtube.Service) error {
+		ctx, cancel := context.WithTimeout(context.Background(), accountSyncTimeout)
+		defer cancel()
+		result.Total = 0
+		added = nil
+		listed := make(map[string]bool)
+		call := service.Subscriptions.List([]string{"snippet"}).Mine(true).MaxResults(50)
+		return call.Pages(ctx, func(response *youtube.SubscriptionListResponse) error {
+			c.useQuota(quotaCostList)
+			for _, item := range response.Items {
+				if item.Snippet == nil || item.Snippet.ResourceId == nil {
+					continue
+				}
+				result.Total++
+				channelID := item.Snippet.ResourceId.ChannelId
+				c.cacheChannelName(channelID, item.Snippet.Title)
+				if subscribed[channelID] || listed[channelID] || c.removedChannels[channelID] {
+					continue
+				}
+				listed[channelID] = true
+				added = append(added, channelID)
+				if at, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt); err == nil {
+					subscribedAt[channelID] = at
+				}
+			}
+			return nil
+		})
+	}
+	err = list(service)
+	if isUnauthorized(err) {
+		// Access was revoked since the token was last renewed, grant it again
+		if service, err = c.reauthorize(context.Background()); err != nil {
+			return result, err
+		}
+		err = list(service)
+	}
+	if err != nil {
+		return result, fmt.Errorf("error listing your subscriptions: %w", apiError(err))
+	}
+	if len(added) == 0 {
+		return result, nil
+	}
+
+	c.ClearSubscriptionCache()
+	result.Added = len(added)
+	if err := c.saveSubscriptions(added, nil); err != nil {
+		// Still show the channels this session
+		c.subscribedChannels = append(c.subscribedChannels, added...)
+		return result, fmt.Errorf("error saving config: %w", err)
+	}
+
+	// Date the channels from when they were subscribed to on YouTube, so
+	// new_channel_backlog_days doesn't hide the backlog of long-followed ones
+	for id, at := range subscribedAt {
+		c.subscribedAt[id] = at
+	}
+	_ = c.updateConfig("subscribed_at", c.subscribedAt)
+	return result, nil
+}
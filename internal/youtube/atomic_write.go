@@ -0,0 +1,34 @@
+package youtube
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// writeFileAtomic writes the data to a temporary file next to path and
+// renames it over path, so a crash mid-write leaves the old file intact
+// rather than a truncated one
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// Cleans up after a failed write, the rename has moved it otherwise
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,53 @@
+package youtube
+
+import "time"
+
+// SetSubscribedAt sets when each channel was subscribed to, loaded from the config
+func (c *Client) SetSubscribedAt(subscribedAt map[string]time.Time) {
+	c.subscribedAt = make(map[string]time.Time, len(subscribedAt))
+	for id, at := range subscribedAt {
+		c.subscribedAt[id] = at
+	}
+}
+
+// SetBacklogDays sets for how many days after subscribing to a channel only
+// its videos published since then are shown, 0 shows its whole backlog
+func (c *Client) SetBacklogDays(days int) {
+	c.backlogDays = days
+}
+
+// recordSubscribed records when channels were subscribed to, and forgets the
+// channels that were unsubscribed from
+func (c *Client) recordSubscribed(added, removed []string) error {
+	if c.subscribedAt == nil {
+		c.subscribedAt = make(map[string]time.Time)
+	}
+	now := c.now()
+	for _, id := range added {
+		c.subscribedAt[id] = now
+	}
+	for _, id := range removed {
+		delete(c.subscribedAt, id)
+	}
+	return c.updateConfig("subscribed_at", c.subscribedAt)
+}
+
+// filterBacklog drops the videos that channels subscribed to in the last
+// backlogDays days published before they were subscribed to, so a new
+// subscription doesn't flood the feed with its old uploads
+func (c *Client) filterBacklog(videos []Video) []Video {
+	if c.backlogDays <= 0 || len(c.subscribedAt) == 0 {
+		return videos
+	}
+
+	cutoff := c.now().AddDate(0, 0, -c.backlogDays)
+	filtered := make([]Video, 0, len(videos))
+	for _, video := range videos {
+		since, ok := c.subscribedAt[video.ChannelID]
+		if ok && since.After(cutoff) && video.PublishedAt.Before(since) {
+			continue
+		}
+		filtered = append(filtered, video)
+	}
+	return filtered
+}
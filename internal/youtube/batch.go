@@ -0,0 +1,135 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// ErrChannelUnavailable is reported for channels the API returned nothing
+// for, usually because the channel was deleted or the ID is invalid
+var ErrChannelUnavailable = errors.New("channel unavailable")
+
+// maxIDsPerRequest is the most IDs the YouTube API accepts in a single list call
+const maxIDsPerRequest = 50
+
+// chunk splits ids into consecutive slices of at most size elements. The
+// returned slices share the backing array of ids.
+func chunk(ids []string, size int) [][]string {
+	if size <= 0 {
+		return nil
+	}
+
+	batches := make([][]string, 0, (len(ids)+size-1)/size)
+	for i := 0; i < len(ids); i += size {
+		end := i + size
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batches = append(batches, ids[i:end:end])
+	}
+	return batches
+}
+
+// uploadsPlaylistID derives a channel's uploads playlist ID from its channel
+// ID, which for standard "UC" channels is the same ID with a "UU" prefix
+func uploadsPlaylistID(channelID string) (string, bool) {
+	if len(channelID) != 24 || !strings.HasPrefix(channelID, "UC") {
+		return "", false
+	}
+	return "UU" + channelID[2:], true
+}
+
+// listChannelsByIDs fetches the given parts for any number of channels,
+// issuing one channels.list request per batch of up to 50 IDs
+func (c *Client) listChannelsByIDs(ctx context.Context, parts []string, channelIDs []string) ([]*youtube.Channel, error) {
+	var channels []*youtube.Channel
+	for _, batch := range idBatches(channelIDs) {
+		c.useQuota(quotaCostList)
+		response, err := c.service.Channels.List(parts).
+			Id(strings.Join(batch, ",")).
+			MaxResults(maxIDsPerRequest).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return channels, fmt.Errorf("error fetching channels: %w", apiError(err))
+		}
+		channels = append(channels, response.Items...)
+	}
+	return channels, nil
+}
+
+// missingChannelIDs returns the requested IDs that have no matching channel in
+// the response. The API silently drops unknown IDs and doesn't preserve the
+// request order, so the response has to be matched up by ID.
+func missingChannelIDs(requested []string, channels []*youtube.Channel) []string {
+	returned := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		returned[channel.Id] = true
+	}
+
+	var missing []string
+	for _, id := range requested {
+		if !returned[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// markChannelsAvailability records which of the requested channels the API
+// returned, so unavailable channels can be flagged instead of vanishing
+func (c *Client) markChannelsAvailability(requested []string, channels []*youtube.Channel) []string {
+	c.channelsMu.Lock()
+	defer c.channelsMu.Unlock()
+	for _, channel := range channels {
+		delete(c.unavailableChannels, channel.Id)
+	}
+	missing := missingChannelIDs(requested, channels)
+	for _, id := range missing {
+		c.unavailableChannels[id] = true
+	}
+	return missing
+}
+
+// IsChannelUnavailable reports whether the API returned nothing for the
+// channel the last time it was requested
+func (c *Client) IsChannelUnavailable(channelID string) bool {
+	c.channelsMu.RLock()
+	defer c.channelsMu.RUnlock()
+	return c.unavailableChannels[channelID]
+}
+
+// SubscriptionCheck is the outcome of checking every subscribed channel
+// against the API
+type SubscriptionCheck struct {
+	Valid []string // Channels the API returned
+	Dead  []string // Channels the API returned nothing for, deleted or invalid
+}
+
+// CheckSubscriptions looks up every subscribed channel with channels.list,
+// 50 IDs per request, and sorts them into valid and dead channels. It costs
+// one quota unit per 50 subscriptions.
+func (c *Client) CheckSubscriptions() (SubscriptionCheck, error) {
+	requested := append([]string(nil), c.subscribedChannels...)
+	channels, err := c.listChannelsByIDs(context.Background(), []string{"id"}, requested)
+	if err != nil {
+		return SubscriptionCheck{}, err
+	}
+
+	var check SubscriptionCheck
+	check.Dead = c.markChannelsAvailability(requested, channels)
+	dead := make(map[string]bool, len(check.Dead))
+	for _, id := range check.Dead {
+		dead[id] = true
+	}
+	for _, id := range requested {
+		if !dead[id] {
+			check.Valid = append(check.Valid, id)
+		}
+	}
+	return check, nil
+}
@@ -0,0 +1,68 @@
+package youtube
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// channelIDs returns n distinct channel IDs
+func channelIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("UC%022d", i)
+	}
+	return ids
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name  string
+		ids   int
+		sizes []int
+	}{
+		{"no IDs", 0, []int{}},
+		{"one ID", 1, []int{1}},
+		{"exactly 50", 50, []int{50}},
+		{"51", 51, []int{50, 1}},
+		{"100", 100, []int{50, 50}},
+		{"101", 101, []int{50, 50, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := channelIDs(tt.ids)
+			batches := chunk(ids, maxIDsPerRequest)
+
+			sizes := make([]int, len(batches))
+			var joined []string
+			for i, batch := range batches {
+				sizes[i] = len(batch)
+				joined = append(joined, batch...)
+			}
+			if !reflect.DeepEqual(sizes, tt.sizes) {
+				t.Errorf("batch sizes = %v, want %v", sizes, tt.sizes)
+			}
+			if len(joined) != len(ids) || (len(ids) > 0 && !reflect.DeepEqual(joined, ids)) {
+				t.Errorf("batches don't add up to the IDs in order")
+			}
+		})
+	}
+}
+
+func TestChunkBatchesDontShareCapacity(t *testing.T) {
+	ids := channelIDs(51)
+	batches := chunk(ids, maxIDsPerRequest)
+
+	// Appending to one batch must not overwrite the first ID of the next
+	_ = append(batches[0], "UCappended")
+	if batches[1][0] != ids[50] {
+		t.Errorf("appending to the first batch changed the second to %q", batches[1][0])
+	}
+}
+
+func TestChunkInvalidSize(t *testing.T) {
+	if batches := chunk(channelIDs(3), 0); batches != nil {
+		t.Errorf("chunk with size 0 = %v, want nil", batches)
+	}
+}
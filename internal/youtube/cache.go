@@ -0,0 +1,223 @@
+package youtube
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// videoCacheVersion is bumped whenever the cached Video format changes, so
+// caches written in an older format are discarded instead of misread
+const videoCacheVersion = 1
+
+// videoCacheFile is the on-disk form of the video cache
+type videoCacheFile struct {
+	Version   int                `json:"version"`
+	FetchedAt time.Time          `json:"fetched_at"`
+	Channels  map[string][]Video `json:"channels"`
+}
+
+// CacheSummary describes the contents of the video cache
+type CacheSummary struct {
+	Path        string                `json:"path"`
+	FetchedAt   time.Time             `json:"fetched_at"`
+	ExpiresAt   time.Time             `json:"expires_at"`
+	TotalVideos int                   `json:"total_videos"`
+	Channels    []ChannelCacheSummary `json:"channels"`
+}
+
+// ChannelCacheSummary describes the cached videos for a single channel
+type ChannelCacheSummary struct {
+	ChannelID   string    `json:"channel_id"`
+	ChannelName string    `json:"channel_name"`
+	VideoCount  int       `json:"video_count"`
+	Newest      time.Time `json:"newest,omitempty"`
+	Oldest      time.Time `json:"oldest,omitempty"`
+}
+
+// getVideoCachePath returns the path to the on-disk video cache
+func (c *Client) getVideoCachePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "video_cache.json"), nil
+}
+
+// loadVideoCache restores the video cache from disk, if present
+func (c *Client) loadVideoCache() error {
+	cachePath, err := c.getVideoCachePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(cachePath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var cache videoCacheFile
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return fmt.Errorf("error parsing video cache: %w", err)
+	}
+	if cache.Version != videoCacheVersion {
+		// Written in an older format, fetch fresh videos instead
+		return nil
+	}
+
+	c.videoCacheMu.Lock()
+	defer c.videoCacheMu.Unlock()
+	if cache.Channels != nil {
+		c.videoCache = cache.Channels
+	}
+	c.lastFetchTime = cache.FetchedAt
+	return nil
+}
+
+// cachedVideos returns a snapshot of the video cache. Fetches replace a
+// channel's slice instead of changing it in place, so the snapshot's slices
+// can be read without holding videoCacheMu.
+func (c *Client) cachedVideos() map[string][]Video {
+	c.videoCacheMu.RLock()
+	defer c.videoCacheMu.RUnlock()
+	return maps.Clone(c.videoCache)
+}
+
+// saveVideoCache writes the video cache to disk
+func (c *Client) saveVideoCache() error {
+	cachePath, err := c.getVideoCachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		return err
+	}
+
+	c.videoCacheMu.RLock()
+	data, err := json.Marshal(videoCacheFile{
+		Version:   videoCacheVersion,
+		FetchedAt: c.lastFetchTime,
+		Channels:  c.videoCache,
+	})
+	c.videoCacheMu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(cachePath, data, 0644)
+}
+
+// GetCacheSummary summarizes the channels, counts and timestamps in the video cache
+func (c *Client) GetCacheSummary() (CacheSummary, error) {
+	cachePath, err := c.getVideoCachePath()
+	if err != nil {
+		return CacheSummary{}, err
+	}
+
+	c.videoCacheMu.RLock()
+	defer c.videoCacheMu.RUnlock()
+
+	summary := CacheSummary{
+		Path:      cachePath,
+		FetchedAt: c.lastFetchTime,
+		Channels:  []ChannelCacheSummary{},
+	}
+	if !c.lastFetchTime.IsZero() {
+		summary.ExpiresAt = c.lastFetchTime.Add(c.cacheDuration)
+	}
+
+	for channelID, videos := range c.videoCache {
+		channel := ChannelCacheSummary{
+			ChannelID:   channelID,
+			ChannelName: channelID,
+			VideoCount:  len(videos),
+		}
+		for _, video := range videos {
+			channel.ChannelName = video.ChannelName
+			if video.PublishedAt.After(channel.Newest) {
+				channel.Newest = video.PublishedAt
+			}
+			if channel.Oldest.IsZero() || video.PublishedAt.Before(channel.Oldest) {
+				channel.Oldest = video.PublishedAt
+			}
+		}
+		summary.TotalVideos += len(videos)
+		summary.Channels = append(summary.Channels, channel)
+	}
+
+	sort.Slice(summary.Channels, func(i, j int) bool {
+		return summary.Channels[i].ChannelName < summary.Channels[j].ChannelName
+	})
+
+	return summary, nil
+}
+
+// DumpVideoCache writes a JSON summary of the video cache to w
+func (c *Client) DumpVideoCache(w io.Writer) error {
+	summary, err := c.GetCacheSummary()
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(summary)
+}
+
+// CacheStatus describes the size and age of each of the client's caches
+type CacheStatus struct {
+	VideoChannels  int
+	Videos         int
+	VideoFileSize  int64     // Size of video_cache.json, 0 if it hasn't been written
+	VideoFetchedAt time.Time // Zero if the videos have never been fetched
+	Subscriptions  int       // Subscriptions in the cached list shown by the subscription manager
+	ChannelNames   int
+}
+
+// GetCacheStatus reports what is currently held in each cache
+func (c *Client) GetCacheStatus() CacheStatus {
+	c.videoCacheMu.RLock()
+	status := CacheStatus{
+		VideoChannels:  len(c.videoCache),
+		VideoFetchedAt: c.lastFetchTime,
+	}
+	for _, videos := range c.videoCache {
+		status.Videos += len(videos)
+	}
+	c.videoCacheMu.RUnlock()
+
+	c.channelsMu.RLock()
+	status.Subscriptions = len(c.cachedSubscriptions)
+	status.ChannelNames = len(c.channelCache)
+	c.channelsMu.RUnlock()
+
+	if cachePath, err := c.getVideoCachePath(); err == nil {
+		if info, err := os.Stat(cachePath); err == nil {
+			status.VideoFileSize = info.Size()
+		}
+	}
+	return status
+}
+
+// ClearSubscriptionCache drops the cached subscription list so the next load fetches it again
+func (c *Client) ClearSubscriptionCache() {
+	c.channelsMu.Lock()
+	defer c.channelsMu.Unlock()
+	c.cachedSubscriptions = nil
+}
+
+// ClearChannelNameCache forgets every cached channel name so they are looked up again
+func (c *Client) ClearChannelNameCache() {
+	c.channelsMu.Lock()
+	defer c.channelsMu.Unlock()
+	c.channelCache = make(map[string]string)
+}
@@ -0,0 +1,57 @@
+package youtube
+
+import "time"
+
+// ChannelCount summarizes a channel's recent uploads, as far as they're cached
+type ChannelCount struct {
+	Videos     int       // Recent uploads cached, up to max_videos
+	Unwatched  int       // Of those, the ones not watched yet
+	LastUpload time.Time // When the newest of them was published, zero without uploads
+}
+
+// countVideos summarizes the videos of a single channel
+func countVideos(videos []Video, watched map[string]bool) ChannelCount {
+	count := ChannelCount{Videos: len(videos)}
+	for _, video := range videos {
+		if !watched[video.ID] {
+			count.Unwatched++
+		}
+		if video.PublishedAt.After(count.LastUpload) {
+			count.LastUpload = video.PublishedAt
+		}
+	}
+	return count
+}
+
+// CachedChannelCount returns the counts for a channel from the video cache,
+// without any API calls. It reports false when the channel's videos aren't
+// cached.
+func (c *Client) CachedChannelCount(channelID string, watched map[string]bool) (ChannelCount, bool) {
+	c.videoCacheMu.RLock()
+	defer c.videoCacheMu.RUnlock()
+	videos, ok := c.videoCache[channelID]
+	if !ok {
+		return ChannelCount{}, false
+	}
+	return countVideos(videos, watched), true
+}
+
+// FetchChannelCounts fetches the videos of the given channels, through the
+// same rate limiter and cache as a refresh, and returns their counts.
+// Channels that fail to load are left out.
+func (c *Client) FetchChannelCounts(channelIDs []string, watched map[string]bool) (map[string]ChannelCount, error) {
+	if err := c.checkQuota(); err != nil {
+		return nil, err
+	}
+	if _, err := c.fetchVideosForChannels(channelIDs); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]ChannelCount, len(channelIDs))
+	for _, channelID := range channelIDs {
+		if count, ok := c.CachedChannelCount(channelID, watched); ok {
+			counts[channelID] = count
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,92 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Chapter is a section of a video, taken from the timestamps in its description
+type Chapter struct {
+	Start time.Duration
+	Title string
+}
+
+// chapterPattern matches description lines such as "00:00 Intro",
+// "4:12 - Topic" or "1:02:33 Outro"
+var chapterPattern = regexp.MustCompile(`^\s*(?:(\d{1,2}):)?(\d{1,2}):(\d{2})\s*[-–—:|]?\s+(.+?)\s*$`)
+
+// ParseChapters extracts the chapter list from a video description. Like
+// YouTube, it only accepts lists that start at 0:00 with at least two
+// chapters in ascending order, so stray timestamps aren't taken as chapters.
+func ParseChapters(description string) []Chapter {
+	var chapters []Chapter
+	for _, line := range strings.Split(description, "\n") {
+		match := chapterPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		hours, _ := strconv.Atoi(match[1])
+		minutes, _ := strconv.Atoi(match[2])
+		seconds, _ := strconv.Atoi(match[3])
+		start := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+
+		if len(chapters) > 0 && start <= chapters[len(chapters)-1].Start {
+			continue
+		}
+		chapters = append(chapters, Chapter{Start: start, Title: match[4]})
+	}
+
+	if len(chapters) < 2 || chapters[0].Start != 0 {
+		return nil
+	}
+	return chapters
+}
+
+// FormatTimestamp formats a chapter start like YouTube does, e.g. 4:12 or 1:02:33
+func (ch Chapter) FormatTimestamp() string {
+	total := int(ch.Start.Seconds())
+	if total >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", total/3600, total%3600/60, total%60)
+	}
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
+
+// GetChapters fetches a video's description and returns its chapters, if it has any
+func (c *Client) GetChapters(videoID string) ([]Chapter, error) {
+	if err := c.checkQuota(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c.useQuota(quotaCostList)
+	response, err := c.service.Videos.List([]string{"snippet"}).
+		Id(videoID).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching video: %w", apiError(err))
+	}
+	if len(response.Items) == 0 {
+		return nil, fmt.Errorf("video not found")
+	}
+
+	return ParseChapters(response.Items[0].Snippet.Description), nil
+}
+
+// PlayVideoFrom plays the video in MPV at the given quality, starting at the
+// given position, e.g. a chapter
+func (c *Client) PlayVideoFrom(video Video, start time.Duration, quality StreamQuality) error {
+	cmd, err := c.playerCommand(video, quality, int(start.Seconds()))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.players.start(video, cmd)
+	return err
+}
@@ -3,15 +3,21 @@ package youtube
 import (
 	"bufio"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/fabean/ytviewer/internal/config"
 	"google.golang.org/api/option"
 	"google.golang.org/api/youtube/v3"
 	"github.com/google/uuid"
@@ -20,21 +26,96 @@ import (
 
 // Video represents a YouTube video
 type Video struct {
-	ID          string
-	Title       string
-	ChannelName string
-	PublishedAt time.Time
-	Thumbnail   string
+	ID             string    `json:"id"`
+	Title          string    `json:"title"`
+	ChannelID      string    `json:"channel_id"`
+	ChannelName    string    `json:"channel_name"`
+	PublishedAt    time.Time `json:"published_at"`
+	Thumbnail      string    `json:"thumbnail,omitempty"`
+	ScheduledStart time.Time `json:"scheduled_start,omitempty"` // Scheduled start for upcoming premieres/streams, zero otherwise
+	ViewCount      uint64    `json:"view_count,omitempty"`
+	CommentCount   uint64    `json:"comment_count,omitempty"`
+	Short          bool      `json:"short,omitempty"` // Detected as a YouTube Short
+	Duration       time.Duration `json:"duration,omitempty"` // Length of the video, zero for live streams and videos cached before it was fetched
+}
+
+const (
+	// activeDiscussionRatio is the comments per view above which a video
+	// counts as having an active discussion. Most videos get well under 1%.
+	activeDiscussionRatio = 0.01
+
+	// activeDiscussionMinComments keeps barely watched videos with a couple
+	// of comments from counting as active
+	activeDiscussionMinComments = 50
+)
+
+// IsUpcoming reports whether the video is a premiere or stream that hasn't
+// started yet at now. Its scheduled start is kept once it has started, until
+// the next fetch.
+func (v Video) IsUpcoming(now time.Time) bool {
+	return v.ScheduledStart.After(now)
+}
+
+// FormatDuration formats the video's length like a chapter start, e.g. 12:34
+// or 1:02:33
+func (v Video) FormatDuration() string {
+	return Chapter{Start: v.Duration}.FormatTimestamp()
+}
+
+// ActiveDiscussion reports whether the video has a lot of comments for how
+// many views it has
+func (v Video) ActiveDiscussion() bool {
+	if v.ViewCount == 0 || v.CommentCount < activeDiscussionMinComments {
+		return false
+	}
+	return float64(v.CommentCount)/float64(v.ViewCount) >= activeDiscussionRatio
+}
+
+// NewerThan reports whether v sorts before other in a newest first feed.
+// Videos published in the same second, as batch uploads often are, are
+// ordered by channel name and then ID so the feed doesn't reshuffle them on
+// every refresh.
+func (v Video) NewerThan(other Video) bool {
+	if !v.PublishedAt.Equal(other.PublishedAt) {
+		return v.PublishedAt.After(other.PublishedAt)
+	}
+	if v.ChannelName != other.ChannelName {
+		return v.ChannelName < other.ChannelName
+	}
+	return v.ID < other.ID
 }
 
 // Subscription represents a YouTube channel subscription
 type Subscription struct {
-	ID              string
-	Title           string
-	Description     string
-	SubscriberCount uint64
-	VideoCount      uint64
-	Thumbnail       string
+	ID              string `json:"id"`
+	Title           string `json:"title"`
+	Description     string `json:"description,omitempty"`
+	SubscriberCount uint64 `json:"subscriber_count"`
+	VideoCount      uint64 `json:"video_count"`
+	Thumbnail       string `json:"thumbnail,omitempty"`
+	Unavailable     bool   `json:"unavailable,omitempty"` // The API returned nothing for this channel, it may have been deleted
+}
+
+// ChannelError records a failure to load videos for a single channel
+type ChannelError struct {
+	ChannelID   string
+	ChannelName string
+	Err         error
+}
+
+// Error implements the error interface
+func (e ChannelError) Error() string {
+	return fmt.Sprintf("%s: %v", e.ChannelName, e.Err)
+}
+
+// FetchResult holds the fetched videos along with any channels that failed to load
+type FetchResult struct {
+	Videos []Video
+	Errors []ChannelError
+	NoUploads map[string]string // Channel ID to name for channels that loaded fine but have no uploads yet
+	QuotaExhaustedUntil time.Time // Set when cached videos were served because the quota is exhausted
+	Offline bool // Set when cached videos were served because YouTube couldn't be reached
+	Refetched int // Channels a soft refresh fetched through the API
 }
 
 // Client handles YouTube API interactions
@@ -42,22 +123,52 @@ type Client struct {
 	service            *youtube.Service
 	subscribedChannels []string
 	maxVideosPerChannel int64
-	mpvOptions         struct {
-		MaxResolution  string
-		HardwareAccel  bool
-		CacheSize      string
-		MarkAsWatched  bool
-	}
+	mpvOptions         config.MPVOptions
+	player             config.Player // Player used instead of MPV, MPV when its command is empty
+	playerArgs         []string // Arguments of the configured player, before the placeholders are expanded
+	maxHeight          int // Highest resolution streamed by default, max_resolution validated
 	cachedSubscriptions []Subscription // Add this field for caching
 	channelCache        map[string]string // Map of channel ID to channel name
 	videoCache          map[string][]Video // Map of channel ID to videos
+	videoCacheMu        sync.RWMutex // Guards videoCache, lastFetchTime and fetchErrors, which fetches update while UI commands read them
 	lastFetchTime       time.Time // When we last fetched videos
+	fetchErrors         []ChannelError // Channels that failed during the last fetch
+	searchChannels      map[string]bool // Channels sourced via search.list instead of the uploads playlist
+	snoozedChannels     map[string]time.Time // Channels hidden from the feed until the given time
+	subscribedAt        map[string]time.Time // When each channel was subscribed to, for channels added since it was recorded
+	backlogDays         int // Days after subscribing during which a channel's older videos are hidden, 0 shows them
+	removedChannels     map[string]bool // Channels unsubscribed from in ytviewer, skipped by the account sync
+	relatedCache        map[string][]Video // Map of video ID to related videos
+	thumbnailQuality    string // Thumbnail size stored on videos, empty for DefaultThumbnailQuality
+	channelResolutions  map[string]string // Channel ID to channel_resolution value
+	unavailableChannels map[string]bool // Channels missing from the last channels.list response
+	channelsMu          sync.RWMutex // Guards channelCache, unavailableChannels and cachedSubscriptions, written by loads running alongside fetches
+	shortURLs           bool // Copy and open youtu.be URLs instead of full watch URLs
+	channelStartOffsets map[string]int // Seconds to skip at the start of each channel's videos
+	sponsorBlock        bool // Skip sponsor, intro and outro segments
+	fullscreen          bool // Open MPV fullscreen
+	geometry            string // MPV window size and position, empty leaves it to MPV
+	playProfile         config.PlayProfile // Streaming settings of the active play profile
+	players             *playerRegistry // MPV processes that are still running
+	quotaResetAt        time.Time // When the exhausted daily quota resets, live fetches are skipped until then
+	fetchLimiter        *adaptiveLimiter // Tunes how many channels are fetched at once, kept across refreshes
+	quotaMu             sync.Mutex // Guards quotaUsage, which the fetch workers update
+	quotaUsage          quotaUsage // Estimated quota used today
+	quotaDirty          bool // quotaUsage changed since it was last saved
+	dataMu              sync.Mutex // Guards sessionData and the data usage file
+	watchedMu           sync.Mutex // Guards watchHistory, read from UI commands on other goroutines
+	seenMu              sync.Mutex // Guards the load, modify and save of the seen videos file
+	watchHistory        map[string]time.Time // Watched videos as loaded from watched.json, nil until first read
+	watchedRetentionDays int // Days watched videos are remembered for, 0 keeps them forever
+	sessionData         int64 // Estimated bytes streamed and downloaded this session
 	cacheDuration       time.Duration // How long to cache videos for
+	clock               Clock // Where the time is read from, the real time outside tests
 	apiKey              string // Add this field to store the API key
+	oauth               config.OAuthCredentials // OAuth client for acting on the user's account, empty when not configured
 }
 
 // NewClient creates a new YouTube client
-func NewClient(apiKey string, subscribedChannels []string, maxVideos int64, mpvOptions interface{}, cacheDuration int) (*Client, error) {
+func NewClient(apiKey string, subscribedChannels []string, maxVideos int64, mpvOptions config.MPVOptions, cacheDuration int) (*Client, error) {
 	ctx := context.Background()
 	service, err := youtube.NewService(ctx, option.WithAPIKey(apiKey))
 	if err != nil {
@@ -69,153 +180,516 @@ func NewClient(apiKey string, subscribedChannels []string, maxVideos int64, mpvO
 		subscribedChannels: subscribedChannels,
 		maxVideosPerChannel: maxVideos,
 		channelCache:        make(map[string]string),
+		snoozedChannels:     make(map[string]time.Time),
+		relatedCache:        make(map[string][]Video),
+		fetchLimiter:        newAdaptiveLimiter(),
+		clock:               RealClock{},
+		players:             newPlayerRegistry(),
+		unavailableChannels: make(map[string]bool),
 		videoCache:          make(map[string][]Video),
 		lastFetchTime:       time.Time{}, // Zero time
 		cacheDuration:       time.Duration(cacheDuration) * time.Minute,
 		apiKey:              apiKey, // Store the API key
 	}
 	
-	// Set MPV options if provided
-	if mpvOptions != nil {
-		if opts, ok := mpvOptions.(struct {
-			MaxResolution  string
-			HardwareAccel  bool
-			CacheSize      string
-			MarkAsWatched  bool
-		}); ok {
-			client.mpvOptions = opts
-		}
+	// Restore the video cache from the previous run. A missing or corrupt
+	// cache just means we fetch fresh videos.
+	if err := client.loadVideoCache(); err != nil {
+		client.ClearVideoCache()
+	}
+	
+	// A missing or corrupt usage file just means the estimate starts from zero
+	_ = client.loadQuotaUsage()
+	
+	// Estimate the data each player used once it exits
+	client.players.onExit = client.recordPlayback
+	
+	// Set MPV options, streaming up to 1080p if max_resolution makes no sense
+	client.mpvOptions = mpvOptions
+	client.maxHeight = DefaultMaxHeight
+	if height, err := parseMaxResolution(mpvOptions.MaxResolution); err != nil {
+		slog.Warn("ignoring mpv_options.max_resolution", "err", err, "using", DefaultMaxHeight)
+	} else {
+		client.maxHeight = height
 	}
 	
 	return client, nil
 }
 
+// MaxVideosPerChannel returns how many videos are fetched per channel
+func (c *Client) MaxVideosPerChannel() int64 {
+	return c.maxVideosPerChannel
+}
+
+// SetMaxVideosPerChannel changes how many videos are fetched per channel.
+// Cached videos keep their old count until the next fetch.
+func (c *Client) SetMaxVideosPerChannel(maxVideos int64) {
+	c.maxVideosPerChannel = maxVideos
+}
+
 // GetSubscribedChannels returns the list of subscribed channel IDs
 func (c *Client) GetSubscribedChannels() []string {
 	return c.subscribedChannels
 }
 
-// GetLatestVideos fetches the latest videos from the subscribed channels
-func (c *Client) GetLatestVideos() ([]Video, error) {
+// GetLatestVideos fetches the latest videos from the subscribed channels.
+// Channels that fail to load are reported in the result rather than aborting the fetch.
+func (c *Client) GetLatestVideos() (FetchResult, error) {
 	// Check if cache is still valid
-	if !c.lastFetchTime.IsZero() && time.Since(c.lastFetchTime) < c.cacheDuration {
-		// Combine all videos from cache
-		var allVideos []Video
-		for _, videos := range c.videoCache {
-			allVideos = append(allVideos, videos...)
-		}
-		
-		// Sort by publish date (newest first)
-		sort.Slice(allVideos, func(i, j int) bool {
-			return allVideos[i].PublishedAt.After(allVideos[j].PublishedAt)
-		})
-		
-		return allVideos, nil
+	c.videoCacheMu.RLock()
+	fresh := !c.lastFetchTime.IsZero() && c.now().Sub(c.lastFetchTime) < c.cacheDuration
+	c.videoCacheMu.RUnlock()
+	if fresh {
+		return c.GetLatestVideosCachedOnly(), nil
+	}
+	
+	// Don't hammer the API while the quota is exhausted, serve the cache instead
+	if err := c.checkQuota(); err != nil {
+		return c.quotaExhaustedResult(), nil
 	}
 	
 	// Cache expired or not initialized, fetch new videos
 	allVideos := make([]Video, 0)
+	var fetchErrors []ChannelError
 	
 	// Process channels in batches to reduce API calls
-	for i := 0; i < len(c.subscribedChannels); i += 50 {
-		end := i + 50
-		if end > len(c.subscribedChannels) {
-			end = len(c.subscribedChannels)
+	for _, batch := range chunk(c.subscribedChannels, maxIDsPerRequest) {
+		batchResult, err := c.fetchVideosForChannels(batch)
+		if c.recordQuotaExceeded(err) {
+			// Serve what we have, including any batches fetched before running out
+			return c.quotaExhaustedResult(), nil
+		}
+		if isNetworkError(err) {
+			return c.offlineResult()
 		}
-		
-		batch := c.subscribedChannels[i:end]
-		batchVideos, err := c.fetchVideosForChannels(batch)
 		if err != nil {
-			return nil, err
+			return FetchResult{}, err
 		}
 		
-		allVideos = append(allVideos, batchVideos...)
+		allVideos = append(allVideos, batchResult.Videos...)
+		fetchErrors = append(fetchErrors, batchResult.Errors...)
 	}
 	
 	// Sort by publish date (newest first)
 	sort.Slice(allVideos, func(i, j int) bool {
-		return allVideos[i].PublishedAt.After(allVideos[j].PublishedAt)
+		return allVideos[i].NewerThan(allVideos[j])
 	})
 	
 	// Update cache timestamp
-	c.lastFetchTime = time.Now()
+	c.videoCacheMu.Lock()
+	c.lastFetchTime = c.now()
+	c.fetchErrors = fetchErrors
+	c.videoCacheMu.Unlock()
+	
+	// Persist the cache for the next run, failing to do so isn't fatal
+	_ = c.saveVideoCache()
 	
-	return allVideos, nil
+	return FetchResult{Videos: c.filterBacklog(c.filterSnoozed(allVideos)), Errors: fetchErrors, NoUploads: c.noUploadChannels()}, nil
 }
 
-// PlayVideo opens the video in MPV with optimized settings
-func (c *Client) PlayVideo(videoID string) error {
-	url := fmt.Sprintf("https://www.youtube.com/watch?v=%s", videoID)
-	
-	// Basic MPV arguments that should work reliably
-	args := []string{
-		// Limit resolution to 1080p
-		"--ytdl-format=bestvideo[height<=1080]+bestaudio/best[height<=1080]",
-		
-		// The video URL (must be the last argument)
-		url,
+// GetVideosSince fetches the subscribed channels regardless of the cache
+// duration, but only returns the videos published after since, newest first.
+// The cache is refreshed with everything fetched, so the next full reload
+// shows the same feed.
+func (c *Client) GetVideosSince(since time.Time) (FetchResult, error) {
+	c.videoCacheMu.Lock()
+	c.lastFetchTime = time.Time{}
+	c.videoCacheMu.Unlock()
+	result, err := c.GetLatestVideos()
+	if err != nil {
+		return result, err
+	}
+
+	newer := make([]Video, 0)
+	for _, video := range result.Videos {
+		if video.PublishedAt.After(since) {
+			newer = append(newer, video)
+		}
+	}
+	result.Videos = newer
+	return result, nil
+}
+
+// GetLatestVideosCachedOnly returns whatever videos are in the cache, even if it
+// has expired, without touching the network
+func (c *Client) GetLatestVideosCachedOnly() FetchResult {
+	// Combine all videos from cache
+	var allVideos []Video
+	c.videoCacheMu.RLock()
+	for _, videos := range c.videoCache {
+		allVideos = append(allVideos, videos...)
 	}
+	fetchErrors := c.fetchErrors
+	c.videoCacheMu.RUnlock()
 	
-	// Create and start the MPV process
-	cmd := exec.Command("mpv", args...)
+	// Sort by publish date (newest first)
+	sort.Slice(allVideos, func(i, j int) bool {
+		return allVideos[i].NewerThan(allVideos[j])
+	})
 	
-	// For debugging: print the command being executed
-	// fmt.Printf("Executing: mpv %s\n", strings.Join(args, " "))
+	return FetchResult{Videos: c.filterBacklog(c.filterSnoozed(allVideos)), Errors: fetchErrors, NoUploads: c.noUploadChannels()}
+}
+
+// noUploadChannels returns the subscribed channels that were fetched
+// successfully but have no videos, as opposed to channels that failed to load
+func (c *Client) noUploadChannels() map[string]string {
+	noUploads := make(map[string]string)
+	cached := c.cachedVideos()
+	for _, channelID := range c.subscribedChannels {
+		if videos, ok := cached[channelID]; ok && len(videos) == 0 {
+			name, ok := c.cachedChannelName(channelID)
+			if !ok {
+				name = channelID
+			}
+			noUploads[channelID] = name
+		}
+	}
+	return noUploads
+}
+
+// offlineResult returns the cached videos flagged as served offline, or
+// ErrOffline if there is nothing cached to show
+func (c *Client) offlineResult() (FetchResult, error) {
+	result := c.GetLatestVideosCachedOnly()
+	if len(result.Videos) == 0 {
+		return FetchResult{}, ErrOffline
+	}
+	result.Offline = true
+	return result, nil
+}
+
+// quotaExhaustedResult returns the cached videos flagged with when the quota resets
+func (c *Client) quotaExhaustedResult() FetchResult {
+	result := c.GetLatestVideosCachedOnly()
+	result.QuotaExhaustedUntil = c.quotaResetAt
+	return result
+}
+
+// SnoozeChannel hides a channel's videos from the feed until the given time
+func (c *Client) SnoozeChannel(channelID string, until time.Time) error {
+	c.snoozedChannels[channelID] = until
+	return c.updateConfig("snoozed_channels", c.snoozedChannels)
+}
+
+// UnsnoozeChannel makes a snoozed channel's videos visible again
+func (c *Client) UnsnoozeChannel(channelID string) error {
+	if _, ok := c.snoozedChannels[channelID]; !ok {
+		return nil
+	}
+	delete(c.snoozedChannels, channelID)
+	return c.updateConfig("snoozed_channels", c.snoozedChannels)
+}
+
+// SnoozedUntil returns when a channel's snooze ends, if it is currently snoozed
+func (c *Client) SnoozedUntil(channelID string) (time.Time, bool) {
+	until, ok := c.snoozedChannels[channelID]
+	if !ok || !c.now().Before(until) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// SetSnoozedChannels sets the snoozed channels loaded from the config
+func (c *Client) SetSnoozedChannels(snoozed map[string]time.Time) {
+	c.snoozedChannels = make(map[string]time.Time, len(snoozed))
+	for id, until := range snoozed {
+		c.snoozedChannels[id] = until
+	}
+}
+
+// filterSnoozed drops videos from snoozed channels and unsnoozes any channel
+// whose snooze has expired
+func (c *Client) filterSnoozed(videos []Video) []Video {
+	if len(c.snoozedChannels) == 0 {
+		return videos
+	}
 	
-	// Start MPV
-	err := cmd.Start()
-	if err != nil {
-		fmt.Printf("Error starting MPV: %v\n", err)
+	// Expire snoozes that have passed
+	now := c.now()
+	expired := false
+	for id, until := range c.snoozedChannels {
+		if !now.Before(until) {
+			delete(c.snoozedChannels, id)
+			expired = true
+		}
+	}
+	if expired {
+		// Best effort, the in-memory state is already correct
+		_ = c.updateConfig("snoozed_channels", c.snoozedChannels)
 	}
 	
-	// If configured to mark videos as watched automatically
-	if c.mpvOptions.MarkAsWatched {
-		// Mark the video as watched
-		if markErr := c.MarkVideoAsWatched(videoID); markErr != nil {
-			fmt.Printf("Error marking video as watched: %v\n", markErr)
+	filtered := make([]Video, 0, len(videos))
+	for _, video := range videos {
+		if _, snoozed := c.snoozedChannels[video.ChannelID]; !snoozed {
+			filtered = append(filtered, video)
 		}
 	}
+	return filtered
+}
+
+// PlayVideo opens the video in MPV with optimized settings
+func (c *Client) PlayVideo(video Video) error {
+	return c.PlayVideoAt(video, QualityDefault)
+}
+
+// PlayVideoAt plays the video in MPV like PlayVideo, at the given quality
+func (c *Client) PlayVideoAt(video Video, quality StreamQuality) error {
+	cmd, err := c.playerCommand(video, quality, c.startOffset(video))
+	if err != nil {
+		return err
+	}
 	
+	// Start MPV, it keeps playing in the background
+	_, err = c.players.start(video, cmd)
 	return err
 }
 
-// GetSubscriptionInfo fetches detailed information about subscribed channels
-func (c *Client) GetSubscriptionInfo() ([]Subscription, error) {
-	// Check if we have cached subscription info
-	if len(c.cachedSubscriptions) > 0 {
-		return c.cachedSubscriptions, nil
+// WatchVideo plays the video in MPV like PlayVideoAt, but waits for the
+// player to exit before returning
+func (c *Client) WatchVideo(video Video, quality StreamQuality) error {
+	cmd, err := c.playerCommand(video, quality, c.startOffset(video))
+	if err != nil {
+		return err
+	}
+	
+	player, err := c.players.start(video, cmd)
+	if err != nil {
+		return err
 	}
+	<-player.done
+	if player.err != nil {
+		return fmt.Errorf("error playing video in MPV: %w", player.err)
+	}
+	
+	return nil
+}
 
-	// Check if there are any subscriptions
-	if len(c.subscribedChannels) == 0 {
-		return nil, fmt.Errorf("no subscriptions found")
+// mpvArgs builds the MPV arguments for playing a video from start seconds in
+func (c *Client) mpvArgs(video Video, quality StreamQuality, start int) []string {
+	url := video.URL()
+	
+	// Basic MPV arguments that should work reliably
+	args := c.streamMPVArgs(video.ChannelID, quality)
+	
+	// Skip the channel's intro or jump to a chapter
+	if start > 0 {
+		args = append(args, fmt.Sprintf("--start=%d", start))
+	}
+	
+	// Skip sponsor segments through the mpv_sponsorblock script
+	args = append(args, c.sponsorBlockMPVArgs()...)
+	
+	// Window size, position and fullscreen
+	args = append(args, c.windowMPVArgs()...)
+	
+	// The video URL (must be the last argument)
+	return append(args, url)
+}
+
+// startOffset returns how many seconds into the video playback should start
+func (c *Client) startOffset(video Video) int {
+	return c.channelStartOffsets[video.ChannelID]
+}
+
+// SetChannelStartOffsets sets how many seconds to skip at the start of each
+// channel's videos, e.g. to jump past a long intro
+func (c *Client) SetChannelStartOffsets(offsets map[string]int) {
+	c.channelStartOffsets = make(map[string]int, len(offsets))
+	for channelID, seconds := range offsets {
+		c.channelStartOffsets[channelID] = seconds
+	}
+}
+
+// OpenInBrowser opens the video in the system's default web browser
+func (c *Client) OpenInBrowser(videoID string) error {
+	return openURL(c.ShareURL(videoID))
+}
+
+// openURL opens the URL in the system's default web browser
+func openURL(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
 	}
+	
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("error opening browser: %w", err)
+	}
+	
+	// Reap the launcher process in the background
+	go cmd.Wait()
+	return nil
+}
+
+// SubscriptionProgress reports incremental progress while loading subscription info
+type SubscriptionProgress struct {
+	Loaded        int            // Channels loaded so far
+	Total         int            // Channels being loaded in total
+	Subscriptions []Subscription // Channels loaded in this step
+	Err           error          // Set if loading failed, no further progress follows
+}
+
+// subscriptionWorkers is how many channels.list batches are fetched at once
+const subscriptionWorkers = 4
 
+// GetSubscriptionInfo fetches detailed information about subscribed channels
+func (c *Client) GetSubscriptionInfo() ([]Subscription, error) {
 	var subscriptions []Subscription
+	for progress := range c.LoadSubscriptionInfo(context.Background()) {
+		if progress.Err != nil {
+			return nil, progress.Err
+		}
+		subscriptions = append(subscriptions, progress.Subscriptions...)
+	}
+	
+	// Sort subscriptions alphabetically by title
+	sortSubscriptions(subscriptions)
+	return subscriptions, nil
+}
 
-	for _, channelID := range c.subscribedChannels {
-		// Create a context with timeout for each request
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// LoadSubscriptionInfo fetches detailed information about subscribed channels
+// concurrently in batches of 50, streaming progress on the returned channel.
+// The channel is closed once loading finishes, fails, or ctx is cancelled.
+func (c *Client) LoadSubscriptionInfo(ctx context.Context) <-chan SubscriptionProgress {
+	progress := make(chan SubscriptionProgress)
+	
+	go func() {
+		defer close(progress)
 		
-		// Get channel info
-		channelResponse, err := c.service.Channels.List([]string{"snippet", "statistics"}).
-			Id(channelID).
-			Context(ctx).
-			Do()
+		send := func(p SubscriptionProgress) bool {
+			select {
+			case progress <- p:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		
+		// Check if we have cached subscription info
+		c.channelsMu.RLock()
+		cached := c.cachedSubscriptions
+		c.channelsMu.RUnlock()
+		if len(cached) > 0 {
+			total := len(cached)
+			send(SubscriptionProgress{Loaded: total, Total: total, Subscriptions: cached})
+			return
+		}
+		
+		// Check if there are any subscriptions
+		if len(c.subscribedChannels) == 0 {
+			send(SubscriptionProgress{Err: fmt.Errorf("no subscriptions found")})
+			return
+		}
+		
+		// Split the channels into batches of 50 (YouTube API limit)
+		batches := chunk(c.subscribedChannels, maxIDsPerRequest)
+		
+		// Fetch the batches with a small pool of workers
+		type batchResult struct {
+			requested     int
+			subscriptions []Subscription
+			err           error
+		}
+		jobs := make(chan []string)
+		results := make(chan batchResult)
+		workerCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		
+		for w := 0; w < subscriptionWorkers && w < len(batches); w++ {
+			go func() {
+				for batch := range jobs {
+					subscriptions, err := c.fetchSubscriptionBatch(workerCtx, batch)
+					select {
+					case results <- batchResult{requested: len(batch), subscriptions: subscriptions, err: err}:
+					case <-workerCtx.Done():
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			defer close(jobs)
+			for _, batch := range batches {
+				select {
+				case jobs <- batch:
+				case <-workerCtx.Done():
+					return
+				}
+			}
+		}()
+		
+		// Collect results and report progress as each batch completes
+		total := len(c.subscribedChannels)
+		loaded := 0
+		var subscriptions []Subscription
+		for range batches {
+			var result batchResult
+			select {
+			case result = <-results:
+			case <-ctx.Done():
+				return
+			}
 			
-		// Cancel the context after the request is done
-		cancel()
+			if result.err != nil {
+				send(SubscriptionProgress{Loaded: loaded, Total: total, Err: result.err})
+				return
+			}
 			
-		if err != nil {
-			return nil, fmt.Errorf("error fetching channel info: %w", err)
-		}
-
-		if len(channelResponse.Items) == 0 {
-			continue
+			loaded += result.requested
+			c.channelsMu.Lock()
+			for i, sub := range result.subscriptions {
+				if sub.Unavailable {
+					c.unavailableChannels[sub.ID] = true
+					if name, ok := c.channelCache[sub.ID]; ok {
+						result.subscriptions[i].Title = name
+					}
+					continue
+				}
+				delete(c.unavailableChannels, sub.ID)
+				c.channelCache[sub.ID] = sub.Title
+			}
+			c.channelsMu.Unlock()
+			// Appended after the names are filled in, since it copies the subscriptions
+			subscriptions = append(subscriptions, result.subscriptions...)
+			if !send(SubscriptionProgress{Loaded: loaded, Total: total, Subscriptions: result.subscriptions}) {
+				return
+			}
 		}
-
-		channel := channelResponse.Items[0]
 		
+		// Cache the subscription info
+		sortSubscriptions(subscriptions)
+		c.channelsMu.Lock()
+		c.cachedSubscriptions = subscriptions
+		c.channelsMu.Unlock()
+	}()
+	
+	return progress
+}
+
+// fetchSubscriptionBatch fetches detailed information for up to 50 channels in one request
+func (c *Client) fetchSubscriptionBatch(ctx context.Context, channelIDs []string) ([]Subscription, error) {
+	// Create a context with timeout for the request
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	
+	// Skip the request while the quota is exhausted
+	if err := c.checkQuota(); err != nil {
+		return nil, err
+	}
+	
+	// Get channel info
+	channels, err := c.listChannelsByIDs(ctx, []string{"snippet", "statistics"}, channelIDs)
+	if err != nil {
+		return nil, err
+	}
+	
+	subscriptions := make([]Subscription, 0, len(channels))
+	for _, channel := range channels {
 		thumbnail := ""
 		if thumbnails := channel.Snippet.Thumbnails; thumbnails != nil {
 			if thumbnails.Medium != nil {
@@ -226,7 +700,7 @@ func (c *Client) GetSubscriptionInfo() ([]Subscription, error) {
 		}
 
 		subscriptions = append(subscriptions, Subscription{
-			ID:              channelID,
+			ID:              channel.Id,
 			Title:           channel.Snippet.Title,
 			Description:     channel.Snippet.Description,
 			SubscriberCount: uint64(channel.Statistics.SubscriberCount),
@@ -234,16 +708,25 @@ func (c *Client) GetSubscriptionInfo() ([]Subscription, error) {
 			Thumbnail:       thumbnail,
 		})
 	}
+	
+	// Keep channels the API didn't return in the list, flagged as unavailable.
+	// This runs on a worker goroutine, so the client's maps are left to the caller.
+	for _, id := range missingChannelIDs(channelIDs, channels) {
+		subscriptions = append(subscriptions, Subscription{
+			ID:          id,
+			Title:       id,
+			Unavailable: true,
+		})
+	}
+	
+	return subscriptions, nil
+}
 
-	// Sort subscriptions alphabetically by title
+// sortSubscriptions sorts subscriptions alphabetically by title
+func sortSubscriptions(subscriptions []Subscription) {
 	sort.Slice(subscriptions, func(i, j int) bool {
 		return strings.ToLower(subscriptions[i].Title) < strings.ToLower(subscriptions[j].Title)
 	})
-
-	// Cache the subscription info
-	c.cachedSubscriptions = subscriptions
-	
-	return subscriptions, nil
 }
 
 // RemoveSubscription removes a channel from subscriptions
@@ -260,79 +743,175 @@ func (c *Client) RemoveSubscription(channelID string) error {
 	if index == -1 {
 		return fmt.Errorf("channel not found in subscriptions")
 	}
+	
+	// Refuse the change before making it if it can't be saved
+	if err := config.CheckWritable(); err != nil {
+		return err
+	}
 
 	// Remove the channel from the list
 	c.subscribedChannels = append(c.subscribedChannels[:index], c.subscribedChannels[index+1:]...)
 	
 	// Clear the cache
-	c.cachedSubscriptions = nil
+	c.ClearSubscriptionCache()
 	
 	// Update the config file
-	return c.saveSubscriptions()
+	return c.saveSubscriptions(nil, []string{channelID})
 }
 
-// saveSubscriptions saves the updated subscription list to the config file
-func (c *Client) saveSubscriptions() error {
-	// Get config directory
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("error getting home directory: %w", err)
+// RemoveSubscriptions removes several channels from subscriptions with a single config write
+func (c *Client) RemoveSubscriptions(channelIDs []string) error {
+	// Refuse the change before making it if it can't be saved
+	if err := config.CheckWritable(); err != nil {
+		return err
 	}
-
-	configDir := filepath.Join(homeDir, ".config", "ytviewer")
-	configPath := filepath.Join(configDir, "config.json")
 	
-	// Read existing config
-	data, err := os.ReadFile(configPath)
-	if err != nil {
-		return fmt.Errorf("error reading config file: %w", err)
+	remove := make(map[string]bool, len(channelIDs))
+	for _, id := range channelIDs {
+		remove[id] = true
 	}
-
-	var config map[string]interface{}
-	if err := json.Unmarshal(data, &config); err != nil {
-		return fmt.Errorf("error parsing config file: %w", err)
+	
+	remaining := make([]string, 0, len(c.subscribedChannels))
+	for _, id := range c.subscribedChannels {
+		if !remove[id] {
+			remaining = append(remaining, id)
+		}
 	}
+	c.subscribedChannels = remaining
 	
-	// Update subscriptions
-	config["subscriptions"] = c.subscribedChannels
+	// Clear the cache
+	c.ClearSubscriptionCache()
 	
-	// Write updated config
-	updatedData, err := json.MarshalIndent(config, "", "  ")
-	if err != nil {
-		return fmt.Errorf("error creating updated config: %w", err)
+	// Update the config file
+	return c.saveSubscriptions(nil, channelIDs)
+}
+
+// StaleChannel is a subscribed channel that hasn't uploaded recently
+type StaleChannel struct {
+	ID         string
+	LastUpload time.Time // Zero if the channel has no uploads
+}
+
+// StaleChannels returns the subscribed channels whose newest cached upload is
+// older than the cutoff, including channels with no uploads at all. Channels
+// missing from the video cache are skipped since their uploads are unknown.
+func (c *Client) StaleChannels(cutoff time.Time) []StaleChannel {
+	var stale []StaleChannel
+	cached := c.cachedVideos()
+	for _, channelID := range c.subscribedChannels {
+		videos, ok := cached[channelID]
+		if !ok {
+			continue
+		}
+		
+		var lastUpload time.Time
+		for _, video := range videos {
+			if video.PublishedAt.After(lastUpload) {
+				lastUpload = video.PublishedAt
+			}
+		}
+		
+		if lastUpload.Before(cutoff) {
+			stale = append(stale, StaleChannel{ID: channelID, LastUpload: lastUpload})
+		}
 	}
+	return stale
+}
 
-	if err := os.WriteFile(configPath, updatedData, 0644); err != nil {
-		return fmt.Errorf("error writing updated config: %w", err)
+// saveSubscriptions adds and removes channels in the config file's
+// subscription list. The change is applied to the list in the file, so
+// channels added or removed by editing it while ytviewer runs are kept, and
+// picked up here too.
+func (c *Client) saveSubscriptions(added, removed []string) error {
+	subscriptions, err := config.UpdateList("subscriptions", func(list []string) []string {
+		return applySubscriptionChange(list, added, removed)
+	})
+	if err != nil {
+		return err
 	}
+	c.subscribedChannels = subscriptions
 	
+	// Best effort, at worst a new channel's backlog shows up in the feed
+	_ = c.recordSubscribed(added, removed)
+	// and at worst the account sync adds a removed channel back
+	_ = c.recordRemoved(added, removed)
 	return nil
 }
 
-// AddSubscription adds a new channel to the subscriptions
-func (c *Client) AddSubscription(channelID string) error {
+// applySubscriptionChange returns the list with the removed channels left out
+// and the added ones appended, unless they're already in it
+func applySubscriptionChange(list, added, removed []string) []string {
+	drop := make(map[string]bool, len(removed))
+	for _, id := range removed {
+		drop[id] = true
+	}
+	result := make([]string, 0, len(list)+len(added))
+	present := make(map[string]bool, len(list))
+	for _, id := range list {
+		if !drop[id] && !present[id] {
+			result = append(result, id)
+			present[id] = true
+		}
+	}
+	for _, id := range added {
+		if !present[id] {
+			result = append(result, id)
+			present[id] = true
+		}
+	}
+	return result
+}
+
+// updateConfig sets a single top-level key in the config file, leaving the rest untouched
+func (c *Client) updateConfig(key string, value interface{}) error {
+	return config.Update(key, value)
+}
+
+// AddSubscription adds a new channel to the subscriptions. The channel can be
+// given by ID or by a /channel/, /user/ or /@handle URL, with or without the
+// scheme and host. It reports whether the channel has any public uploads, so
+// callers can explain an empty feed.
+func (c *Client) AddSubscription(channelID string) (bool, error) {
+	// Refuse the change before spending quota on it if it can't be saved
+	if err := config.CheckWritable(); err != nil {
+		return false, err
+	}
+	
+	// Channel IDs never contain these, URLs and handles always do
+	if strings.ContainsAny(channelID, "/@.") {
+		resolved, err := c.resolveChannelRef(channelRef{URL: channelID})
+		if err != nil {
+			return false, err
+		}
+		channelID = resolved
+	}
+	
 	// Validate the channel ID
+	if err := checkQueryValue("channel ID", channelID); err != nil {
+		return false, err
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	
 	// Check if the channel exists
-	channelResponse, err := c.service.Channels.List([]string{"snippet"}).
+	c.useQuota(quotaCostList)
+	channelResponse, err := c.service.Channels.List([]string{"snippet", "statistics"}).
 		Id(channelID).
 		Context(ctx).
 		Do()
 		
 	if err != nil {
-		return fmt.Errorf("error checking channel: %w", err)
+		return false, fmt.Errorf("error checking channel: %w", apiError(err))
 	}
 	
 	if len(channelResponse.Items) == 0 {
-		return fmt.Errorf("channel not found")
+		return false, fmt.Errorf("channel not found")
 	}
 	
 	// Check if already subscribed
 	for _, subID := range c.subscribedChannels {
 		if subID == channelID {
-			return fmt.Errorf("already subscribed to this channel")
+			return false, fmt.Errorf("already subscribed to this channel")
 		}
 	}
 	
@@ -340,34 +919,39 @@ func (c *Client) AddSubscription(channelID string) error {
 	c.subscribedChannels = append(c.subscribedChannels, channelID)
 	
 	// Clear the cache so it will be refreshed
-	c.cachedSubscriptions = nil
+	c.ClearSubscriptionCache()
 	
 	// Save to config file
-	err = c.saveSubscriptions()
+	err = c.saveSubscriptions([]string{channelID}, nil)
 	if err != nil {
-		return fmt.Errorf("error saving config: %w", err)
+		return false, fmt.Errorf("error saving config: %w", err)
 	}
 	
-	return nil
+	statistics := channelResponse.Items[0].Statistics
+	return statistics == nil || statistics.VideoCount > 0, nil
 }
 
 // GetChannelName fetches the name of a channel
 func (c *Client) GetChannelName(channelID string) (string, error) {
 	// Check if the channel name is in the cache
-	if name, ok := c.channelCache[channelID]; ok {
+	if name, ok := c.cachedChannelName(channelID); ok {
 		return name, nil
 	}
 	
 	// If not in cache, fetch from API
+	if err := checkQueryValue("channel ID", channelID); err != nil {
+		return "", err
+	}
 	service, err := youtube.NewService(context.Background(), option.WithAPIKey(c.apiKey))
 	if err != nil {
 		return "", fmt.Errorf("error creating YouTube service: %w", err)
 	}
 	
 	call := service.Channels.List([]string{"snippet"}).Id(channelID)
+	c.useQuota(quotaCostList)
 	response, err := call.Do()
 	if err != nil {
-		return "", fmt.Errorf("error fetching channel: %w", err)
+		return "", fmt.Errorf("error fetching channel: %w", apiError(err))
 	}
 	
 	if len(response.Items) == 0 {
@@ -376,127 +960,155 @@ func (c *Client) GetChannelName(channelID string) (string, error) {
 	
 	// Store in cache and return
 	channelName := response.Items[0].Snippet.Title
-	c.channelCache[channelID] = channelName
+	c.cacheChannelName(channelID, channelName)
 	return channelName, nil
 }
 
 // GetSubscribedChannelNames fetches all subscribed channel names
 func (c *Client) GetSubscribedChannelNames() (map[string]string, error) {
-	result := make(map[string]string)
-	var missingChannels []string
-	
-	// Check which channels we need to fetch
-	for _, channelID := range c.subscribedChannels {
-		if name, ok := c.channelCache[channelID]; ok {
-			result[channelID] = name
-		} else {
-			missingChannels = append(missingChannels, channelID)
-		}
-	}
-	
-	// If all channels are cached, return immediately
-	if len(missingChannels) == 0 {
-		return result, nil
-	}
-	
-	// Fetch missing channels in batches to reduce API calls
-	service, err := youtube.NewService(context.Background(), option.WithAPIKey(c.apiKey))
-	if err != nil {
-		return result, fmt.Errorf("error creating YouTube service: %w", err)
-	}
-	
-	// Process in batches of 50 (YouTube API limit)
-	for i := 0; i < len(missingChannels); i += 50 {
-		end := i + 50
-		if end > len(missingChannels) {
-			end = len(missingChannels)
-		}
-		
-		batch := missingChannels[i:end]
-		call := service.Channels.List([]string{"snippet"}).Id(strings.Join(batch, ","))
-		response, err := call.Do()
-		if err != nil {
-			return result, fmt.Errorf("error fetching channels: %w", err)
-		}
-		
-		// Add to cache and result
-		for _, item := range response.Items {
-			c.channelCache[item.Id] = item.Snippet.Title
-			result[item.Id] = item.Snippet.Title
-		}
-	}
-	
-	return result, nil
+	return c.GetChannelNamesForIDs(c.subscribedChannels)
 }
 
 // Add a new method to fetch videos for multiple channels at once
-func (c *Client) fetchVideosForChannels(channelIDs []string) ([]Video, error) {
+func (c *Client) fetchVideosForChannels(channelIDs []string) (FetchResult, error) {
+	// Best effort, the usage is only an estimate
+	defer c.SaveQuotaUsage()
+
 	var allVideos []Video
+	var fetchErrors []ChannelError
+	var fetchedChannelIDs []string
 	
 	// First, get all channel uploads playlist IDs in one API call
 	service, err := youtube.NewService(context.Background(), option.WithAPIKey(c.apiKey))
 	if err != nil {
-		return nil, fmt.Errorf("error creating YouTube service: %w", err)
+		return FetchResult{}, fmt.Errorf("error creating YouTube service: %w", err)
 	}
 	
-	// Get channel details (including uploads playlist ID) in one API call
-	channelsCall := service.Channels.List([]string{"contentDetails"}).Id(strings.Join(channelIDs, ","))
-	channelsResponse, err := channelsCall.Do()
-	if err != nil {
-		return nil, fmt.Errorf("error fetching channels: %w", err)
+	// Derive the uploads playlist of standard channel IDs directly, only
+	// channels with other IDs need a channels.list lookup
+	uploadsPlaylists := make(map[string]string, len(channelIDs))
+	derived := make(map[string]bool, len(channelIDs))
+	var lookupIDs []string
+	for _, channelID := range channelIDs {
+		if playlistID, ok := uploadsPlaylistID(channelID); ok {
+			uploadsPlaylists[channelID] = playlistID
+			derived[channelID] = true
+		} else {
+			lookupIDs = append(lookupIDs, channelID)
+		}
 	}
 	
-	// Process each channel's uploads playlist
-	for _, channel := range channelsResponse.Items {
-		channelID := channel.Id
-		uploadsPlaylistID := channel.ContentDetails.RelatedPlaylists.Uploads
-		
-		// Fetch videos from uploads playlist
-		playlistCall := service.PlaylistItems.List([]string{"snippet"}).
-			PlaylistId(uploadsPlaylistID).
-			MaxResults(c.maxVideosPerChannel)
-		
-		playlistResponse, err := playlistCall.Do()
+	if len(lookupIDs) > 0 {
+		// Get channel details (including uploads playlist ID) in one API call per batch
+		channels, err := c.listChannelsByIDs(context.Background(), []string{"contentDetails"}, lookupIDs)
 		if err != nil {
-			// Log error but continue with other channels
-			fmt.Printf("Error fetching videos for channel %s: %v\n", channelID, err)
-			continue
+			return FetchResult{}, err
+		}
+		for _, channel := range channels {
+			uploadsPlaylists[channel.Id] = channel.ContentDetails.RelatedPlaylists.Uploads
 		}
 		
-		// Process videos
-		channelVideos := make([]Video, 0, len(playlistResponse.Items))
-		for _, item := range playlistResponse.Items {
-			// Get channel name from cache if available
-			channelName, ok := c.channelCache[channelID]
-			if !ok {
-				// If not in cache, use channel ID temporarily
-				// We'll populate it later with the batch channel name fetch
-				channelName = channelID
+		// Report channels the API didn't return rather than silently dropping them
+		for _, channelID := range c.markChannelsAvailability(lookupIDs, channels) {
+			fetchErrors = append(fetchErrors, c.channelError(channelID, ErrChannelUnavailable))
+		}
+	}
+	
+	// Keep the requested order for the channels that have a playlist to fetch
+	var channels []string
+	for _, channelID := range channelIDs {
+		if _, ok := uploadsPlaylists[channelID]; ok {
+			channels = append(channels, channelID)
+		}
+	}
+	
+	// Fetch each channel's videos concurrently, as many at once as the
+	// limiter currently allows
+	slog.Debug("fetching channel videos", "channels", len(channels), "concurrency", c.fetchLimiter.Limit())
+	results := make([][]Video, len(channels))
+	resultErrs := make([]error, len(channels))
+	var quotaExhausted atomic.Bool
+	var wg sync.WaitGroup
+	for i, channelID := range channels {
+		wg.Add(1)
+		go func(i int, channelID string) {
+			defer wg.Done()
+			c.fetchLimiter.acquire()
+			
+			// Every remaining request would fail too once the quota is gone
+			if quotaExhausted.Load() {
+				c.fetchLimiter.release(0, nil)
+				return
 			}
 			
-			// Parse the published time
-			publishedAt, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
-			if err != nil {
-				// Use current time as fallback
-				publishedAt = time.Now()
+			// Fetch videos from the uploads playlist, or via search for channels
+			// whose uploads playlist is known to under-report
+			start := time.Now()
+			if c.searchChannels[channelID] {
+				results[i], resultErrs[i] = c.searchChannelVideos(service, channelID)
+			} else {
+				results[i], resultErrs[i] = c.playlistChannelVideos(service, channelID, uploadsPlaylists[channelID])
 			}
+			c.fetchLimiter.release(time.Since(start), resultErrs[i])
 			
-			video := Video{
-				ID:          item.Snippet.ResourceId.VideoId,
-				Title:       item.Snippet.Title,
-				ChannelName: channelName,
-				PublishedAt: publishedAt,
-				Thumbnail:   item.Snippet.Thumbnails.Medium.Url,
+			var quotaErr *QuotaExceededError
+			if errors.As(resultErrs[i], &quotaErr) {
+				quotaExhausted.Store(true)
+			}
+		}(i, channelID)
+	}
+	wg.Wait()
+	slog.Debug("fetched channel videos", "concurrency", c.fetchLimiter.Limit())
+	
+	// A derived uploads playlist that doesn't exist means either a channel
+	// without uploads or a channel that doesn't exist, only the API can tell
+	var unverifiedIDs []string
+	for i, channelID := range channels {
+		if errors.Is(resultErrs[i], errNoUploadsPlaylist) && derived[channelID] {
+			unverifiedIDs = append(unverifiedIDs, channelID)
+		} else if resultErrs[i] == nil && derived[channelID] {
+			c.channelsMu.Lock()
+			delete(c.unavailableChannels, channelID)
+			c.channelsMu.Unlock()
+		}
+	}
+	unavailable := make(map[string]bool)
+	if len(unverifiedIDs) > 0 {
+		verified, err := c.listChannelsByIDs(context.Background(), []string{"id"}, unverifiedIDs)
+		if err == nil {
+			for _, channelID := range c.markChannelsAvailability(unverifiedIDs, verified) {
+				unavailable[channelID] = true
+			}
+		}
+	}
+	
+	for i, channelID := range channels {
+		if errors.Is(resultErrs[i], errNoUploadsPlaylist) {
+			if unavailable[channelID] {
+				fetchErrors = append(fetchErrors, c.channelError(channelID, ErrChannelUnavailable))
+				continue
+			}
+			resultErrs[i] = nil
+		}
+		if err := resultErrs[i]; err != nil {
+			// Every other channel would fail too once the quota is gone or the network is down
+			var quotaErr *QuotaExceededError
+			if errors.As(err, &quotaErr) || isNetworkError(err) {
+				return FetchResult{}, err
 			}
 			
-			channelVideos = append(channelVideos, video)
+			// Record the error but continue with other channels
+			fetchErrors = append(fetchErrors, c.channelError(channelID, err))
+			continue
 		}
-		
-		// Update video cache for this channel
-		c.videoCache[channelID] = channelVideos
-		allVideos = append(allVideos, channelVideos...)
+		fetchedChannelIDs = append(fetchedChannelIDs, channelID)
+		allVideos = append(allVideos, results[i]...)
 	}
 	
+	// Look up premiere/stream schedules, durations and view/comment counts for the fetched videos in batches.
+	// Failures aren't fatal, the videos are still usable without the extra details.
+	_ = c.enrichVideoDetails(service, allVideos)
+	
 	// Now fetch any missing channel names in a single batch request
 	var missingChannelIDs []string
 	channelIDToVideos := make(map[string][]int) // Map channel ID to indices in allVideos
@@ -527,8 +1139,9 @@ func (c *Client) fetchVideosForChannels(channelIDs []string) ([]Video, error) {
 	if len(missingChannelIDs) > 0 {
 		channelNames, err := c.GetChannelNamesForIDs(missingChannelIDs)
 		if err != nil {
-			// Log error but continue with channel IDs as names
-			fmt.Printf("Error fetching channel names: %v\n", err)
+			// Log error but continue with channel IDs as names. Not to
+			// stdout, the TUI owns the terminal and --pick prints the URL there.
+			slog.Warn("error fetching channel names, showing channel IDs", "err", err)
 		} else {
 			// Update videos with channel names
 			for channelID, indices := range channelIDToVideos {
@@ -537,175 +1150,318 @@ func (c *Client) fetchVideosForChannels(channelIDs []string) ([]Video, error) {
 					for _, idx := range indices {
 						allVideos[idx].ChannelName = name
 					}
-					
-					// Also update in video cache
-					if videos, ok := c.videoCache[channelID]; ok {
-						for i := range videos {
-							c.videoCache[channelID][i].ChannelName = name
-						}
-					}
 				}
 			}
 		}
 	}
 	
-	return allVideos, nil
+	// Update video cache for each channel. This comes after the names are
+	// filled in since readers hold on to the cached slices, so they're only
+	// ever replaced, never changed in place.
+	channelVideos := make(map[string][]Video)
+	for _, video := range allVideos {
+		channelVideos[video.ChannelID] = append(channelVideos[video.ChannelID], video)
+	}
+	c.videoCacheMu.Lock()
+	for _, channelID := range fetchedChannelIDs {
+		c.videoCache[channelID] = channelVideos[channelID]
+	}
+	c.videoCacheMu.Unlock()
+	
+	return FetchResult{Videos: allVideos, Errors: fetchErrors}, nil
 }
 
-// Add a method to get multiple channel names at once
-func (c *Client) GetChannelNamesForIDs(channelIDs []string) (map[string]string, error) {
-	result := make(map[string]string)
-	var missingChannels []string
-	
-	// Check which channels we need to fetch
-	for _, channelID := range channelIDs {
-		if name, ok := c.channelCache[channelID]; ok {
-			result[channelID] = name
-		} else {
-			missingChannels = append(missingChannels, channelID)
-		}
+// channelError builds the error reported for a channel that couldn't be fetched
+func (c *Client) channelError(channelID string, err error) ChannelError {
+	channelName, ok := c.cachedChannelName(channelID)
+	if !ok {
+		channelName = channelID
 	}
-	
-	// If all channels are cached, return immediately
-	if len(missingChannels) == 0 {
-		return result, nil
+	return ChannelError{
+		ChannelID:   channelID,
+		ChannelName: channelName,
+		Err:         err,
+	}
+}
+
+// cachedChannelName returns the name of a channel from the channel name
+// cache. The fetch workers read it while subscription loads fill it in.
+func (c *Client) cachedChannelName(channelID string) (string, bool) {
+	c.channelsMu.RLock()
+	defer c.channelsMu.RUnlock()
+	name, ok := c.channelCache[channelID]
+	return name, ok
+}
+
+// cacheChannelName stores the name of a channel in the channel name cache
+func (c *Client) cacheChannelName(channelID, name string) {
+	c.channelsMu.Lock()
+	defer c.channelsMu.Unlock()
+	c.channelCache[channelID] = name
+}
+
+// errNoUploadsPlaylist is returned along with no videos when a channel's
+// uploads playlist doesn't exist
+var errNoUploadsPlaylist = errors.New("uploads playlist not found")
+
+// playlistChannelVideos fetches a channel's latest videos from its uploads playlist
+func (c *Client) playlistChannelVideos(service *youtube.Service, channelID, uploadsPlaylistID string) ([]Video, error) {
+	// Channels that have never uploaded may not have an uploads playlist yet
+	if uploadsPlaylistID == "" {
+		return []Video{}, nil
 	}
 	
-	// Fetch missing channels in batches to reduce API calls
-	service, err := youtube.NewService(context.Background(), option.WithAPIKey(c.apiKey))
+	playlistCall := service.PlaylistItems.List([]string{"snippet"}).
+		PlaylistId(uploadsPlaylistID).
+		MaxResults(c.maxVideosPerChannel)
+	
+	c.useQuota(quotaCostList)
+	playlistResponse, err := playlistCall.Do()
+	if isPlaylistNotFound(err) {
+		// The uploads playlist of a channel without uploads reports as not found
+		return []Video{}, errNoUploadsPlaylist
+	}
 	if err != nil {
-		return result, fmt.Errorf("error creating YouTube service: %w", err)
+		return nil, apiError(err)
 	}
 	
-	// Process in batches of 50 (YouTube API limit)
-	for i := 0; i < len(missingChannels); i += 50 {
-		end := i + 50
-		if end > len(missingChannels) {
-			end = len(missingChannels)
+	// Process videos
+	channelVideos := make([]Video, 0, len(playlistResponse.Items))
+	for _, item := range playlistResponse.Items {
+		// Get channel name from cache if available
+		channelName, ok := c.cachedChannelName(channelID)
+		if !ok {
+			// If not in cache, use channel ID temporarily
+			// We'll populate it later with the batch channel name fetch
+			channelName = channelID
 		}
 		
-		batch := missingChannels[i:end]
-		call := service.Channels.List([]string{"snippet"}).Id(strings.Join(batch, ","))
-		response, err := call.Do()
+		// Parse the published time
+		publishedAt, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
 		if err != nil {
-			return result, fmt.Errorf("error fetching channels: %w", err)
+			// Use current time as fallback
+			publishedAt = c.now()
 		}
 		
-		// Add to cache and result
-		for _, item := range response.Items {
-			c.channelCache[item.Id] = item.Snippet.Title
-			result[item.Id] = item.Snippet.Title
+		video := Video{
+			ID:          item.Snippet.ResourceId.VideoId,
+			Title:       item.Snippet.Title,
+			ChannelID:   channelID,
+			ChannelName: channelName,
+			PublishedAt: publishedAt,
+			Thumbnail:   c.thumbnailURL(item.Snippet.Thumbnails),
+			Short:       looksLikeShort(item.Snippet.Title, item.Snippet.Description),
 		}
+		
+		channelVideos = append(channelVideos, video)
 	}
 	
-	return result, nil
+	return channelVideos, nil
 }
 
-// ClearVideoCache clears the video cache to force a fresh fetch
-func (c *Client) ClearVideoCache() {
-	c.videoCache = make(map[string][]Video)
-	c.lastFetchTime = time.Time{} // Zero time
-}
-
-// MarkVideoAsWatched marks a video as watched and saves to persistent storage
-func (c *Client) MarkVideoAsWatched(videoID string) error {
-	watchedVideos, err := c.GetWatchedVideos()
+// searchChannelVideos fetches a channel's latest videos using search.list ordered
+// by date. This costs 100 quota units per call (versus 1 for playlistItems.list),
+// so it is only used for channels explicitly listed in search_channels.
+func (c *Client) searchChannelVideos(service *youtube.Service, channelID string) ([]Video, error) {
+	searchCall := service.Search.List([]string{"snippet"}).
+		ChannelId(channelID).
+		Type("video").
+		Order("date").
+		MaxResults(c.maxVideosPerChannel)
+	
+	c.useQuota(quotaCostSearch)
+	searchResponse, err := searchCall.Do()
 	if err != nil {
-		return err
+		return nil, apiError(err)
 	}
 	
-	// Mark as watched
-	watchedVideos[videoID] = true
+	channelVideos := make([]Video, 0, len(searchResponse.Items))
+	for _, item := range searchResponse.Items {
+		if item.Id == nil || item.Id.VideoId == "" {
+			continue
+		}
+		
+		channelName, ok := c.cachedChannelName(channelID)
+		if !ok {
+			channelName = channelID
+		}
+		
+		publishedAt, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
+		if err != nil {
+			publishedAt = c.now()
+		}
+		
+		channelVideos = append(channelVideos, Video{
+			ID:          item.Id.VideoId,
+			Title:       item.Snippet.Title,
+			ChannelID:   channelID,
+			ChannelName: channelName,
+			PublishedAt: publishedAt,
+			Thumbnail:   c.thumbnailURL(item.Snippet.Thumbnails),
+			Short:       looksLikeShort(item.Snippet.Title, item.Snippet.Description),
+		})
+	}
 	
-	// Save to file
-	return c.saveWatchedVideos(watchedVideos)
+	return channelVideos, nil
 }
 
-// GetWatchedVideos returns a map of video IDs that have been watched
-func (c *Client) GetWatchedVideos() (map[string]bool, error) {
-	watchedVideos := make(map[string]bool)
-	
-	// Get the watched videos file path
-	watchedPath, err := c.getWatchedVideosPath()
-	if err != nil {
-		return watchedVideos, err
-	}
+// enrichVideoDetails fetches extra per-video details (such as premiere schedules, durations and
+// statistics) with videos.list in batches of 50 and applies them to the videos in place. Each
+// batch costs 1 quota unit however many parts are requested.
+func (c *Client) enrichVideoDetails(service *youtube.Service, videos []Video) error {
+	parts := []string{"liveStreamingDetails", "statistics", "contentDetails"}
 	
-	// Check if file exists
-	if _, err := os.Stat(watchedPath); os.IsNotExist(err) {
-		// File doesn't exist yet, return empty map
-		return watchedVideos, nil
-	}
-	
-	// Read the file
-	data, err := os.ReadFile(watchedPath)
-	if err != nil {
-		return watchedVideos, err
+	indices := make(map[string][]int, len(videos))
+	ids := make([]string, 0, len(videos))
+	for i, video := range videos {
+		if _, ok := indices[video.ID]; !ok {
+			ids = append(ids, video.ID)
+		}
+		indices[video.ID] = append(indices[video.ID], i)
 	}
 	
-	// Parse JSON
-	if len(data) > 0 {
-		err = json.Unmarshal(data, &watchedVideos)
+	// Process in batches of up to 50 (YouTube API limit)
+	for _, batch := range idBatches(ids) {
+		c.useQuota(quotaCostList)
+		response, err := service.Videos.List(parts).
+			Id(strings.Join(batch, ",")).
+			Do()
 		if err != nil {
-			return watchedVideos, err
+			return apiError(err)
+		}
+		
+		for _, item := range response.Items {
+			if stats := item.Statistics; stats != nil {
+				for _, idx := range indices[item.Id] {
+					videos[idx].ViewCount = stats.ViewCount
+					videos[idx].CommentCount = stats.CommentCount
+				}
+			}
+			
+			// Settle the videos the cheap Shorts signals missed by their length
+			if item.ContentDetails != nil {
+				if duration, ok := parseISODuration(item.ContentDetails.Duration); ok {
+					for _, idx := range indices[item.Id] {
+						videos[idx].Duration = duration
+						if duration > 0 && duration <= maxShortDuration {
+							videos[idx].Short = true
+						}
+					}
+				}
+			}
+			
+			details := item.LiveStreamingDetails
+			if details == nil || details.ScheduledStartTime == "" || details.ActualStartTime != "" {
+				continue
+			}
+			
+			scheduledStart, err := time.Parse(time.RFC3339, details.ScheduledStartTime)
+			if err != nil {
+				continue
+			}
+			
+			for _, idx := range indices[item.Id] {
+				videos[idx].ScheduledStart = scheduledStart
+			}
 		}
 	}
 	
-	return watchedVideos, nil
+	return nil
 }
 
-// saveWatchedVideos saves the watched videos map to a file
-func (c *Client) saveWatchedVideos(watchedVideos map[string]bool) error {
-	// Get the watched videos file path
-	watchedPath, err := c.getWatchedVideosPath()
-	if err != nil {
-		return err
+// SetSearchChannels sets the channels whose videos are sourced via search.list
+// instead of their uploads playlist
+func (c *Client) SetSearchChannels(channelIDs []string) {
+	c.searchChannels = make(map[string]bool, len(channelIDs))
+	for _, id := range channelIDs {
+		c.searchChannels[id] = true
 	}
+}
+
+// Add a method to get multiple channel names at once
+func (c *Client) GetChannelNamesForIDs(channelIDs []string) (map[string]string, error) {
+	result := make(map[string]string)
+	var missingChannels []string
 	
-	// Ensure directory exists
-	dir := filepath.Dir(watchedPath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return err
+	// Check which channels we need to fetch
+	for _, channelID := range channelIDs {
+		if name, ok := c.cachedChannelName(channelID); ok {
+			result[channelID] = name
+		} else {
+			missingChannels = append(missingChannels, channelID)
+		}
 	}
 	
-	// Marshal to JSON
-	data, err := json.MarshalIndent(watchedVideos, "", "  ")
-	if err != nil {
-		return err
+	// If all channels are cached, return immediately
+	if len(missingChannels) == 0 {
+		return result, nil
 	}
 	
-	// Write to file
-	return os.WriteFile(watchedPath, data, 0644)
-}
-
-// getWatchedVideosPath returns the path to the watched videos file
-func (c *Client) getWatchedVideosPath() (string, error) {
-	homeDir, err := os.UserHomeDir()
+	// Fetch missing channels in batches to reduce API calls
+	channels, err := c.listChannelsByIDs(context.Background(), []string{"snippet"}, missingChannels)
+	
+	// Add to cache and result, keeping any batches that succeeded
+	for _, item := range channels {
+		c.cacheChannelName(item.Id, item.Snippet.Title)
+		result[item.Id] = item.Snippet.Title
+	}
 	if err != nil {
-		return "", err
+		return result, err
 	}
 	
-	return filepath.Join(homeDir, ".config", "ytviewer", "watched.json"), nil
+	// Channels the API didn't return are left out of the result and can be
+	// checked with IsChannelUnavailable
+	c.markChannelsAvailability(missingChannels, channels)
+	
+	return result, nil
 }
 
-// IsVideoWatched checks if a video has been watched
-func (c *Client) IsVideoWatched(videoID string) (bool, error) {
-	watchedVideos, err := c.GetWatchedVideos()
+// ClearVideoCache clears the video cache, both in memory and on disk, to force a fresh fetch
+func (c *Client) ClearVideoCache() error {
+	c.videoCacheMu.Lock()
+	c.videoCache = make(map[string][]Video)
+	c.lastFetchTime = time.Time{} // Zero time
+	c.videoCacheMu.Unlock()
+	
+	cachePath, err := c.getVideoCachePath()
 	if err != nil {
-		return false, err
+		return err
 	}
-	
-	return watchedVideos[videoID], nil
+	if err := os.Remove(cachePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing video cache: %w", err)
+	}
+	return nil
 }
 
 // CopyVideoURLToClipboard copies the video URL to the system clipboard
 func (c *Client) CopyVideoURLToClipboard(videoID string) error {
-	url := fmt.Sprintf("https://www.youtube.com/watch?v=%s", videoID)
-	return clipboard.WriteAll(url)
+	return clipboard.WriteAll(c.ShareURL(videoID))
 }
 
-// DownloadVideo downloads the video using yt-dlp
-func (c *Client) DownloadVideo(videoID string) error {
+// ChannelFromClipboard returns the channel ID, @handle or channel URL on the
+// system clipboard, and false if the clipboard holds anything else
+func (c *Client) ChannelFromClipboard() (string, bool) {
+	text, err := clipboard.ReadAll()
+	if err != nil {
+		return "", false
+	}
+	text = strings.TrimSpace(text)
+	return text, looksLikeChannel(text)
+}
+
+// CopyVideoURLsToClipboard copies the URLs of several videos to the system
+// clipboard, one per line
+func (c *Client) CopyVideoURLsToClipboard(videoIDs []string) error {
+	urls := make([]string, len(videoIDs))
+	for i, videoID := range videoIDs {
+		urls[i] = c.ShareURL(videoID)
+	}
+	return clipboard.WriteAll(strings.Join(urls, "\n"))
+}
+
+// DownloadVideo downloads the video using yt-dlp, calling progress with the
+// percentage downloaded as yt-dlp reports it. progress may be nil.
+func (c *Client) DownloadVideo(videoID string, progress func(percent float64)) error {
 	// Create downloads directory if it doesn't exist
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -717,16 +1473,20 @@ func (c *Client) DownloadVideo(videoID string) error {
 		return fmt.Errorf("error creating output directory: %w", err)
 	}
 
-	url := fmt.Sprintf("https://www.youtube.com/watch?v=%s", videoID)
+	url := VideoURL(videoID)
 
 	// Prepare yt-dlp command with best quality and progress output
+	format := "bestvideo+bestaudio/best"
 	args := []string{
-		"--format", "bestvideo+bestaudio/best",
+		"--format", format,
 		"--output", filepath.Join(outputDir, "%(title)s.%(ext)s"),
 		"--newline", // Ensure each progress update is on a new line
-		"--progress-template", "%(progress._percent_str)s",
-		url,
+		"--progress-template", "%(progress._percent_str)s %(info.duration)s",
 	}
+	
+	// Cut sponsor segments out of the download
+	args = append(args, c.sponsorBlockDownloadArgs()...)
+	args = append(args, url)
 
 	cmd := exec.Command("yt-dlp", args...)
 
@@ -755,13 +1515,22 @@ func (c *Client) DownloadVideo(videoID string) error {
 		}
 	}()
 
-	// Read stdout and update progress
+	// Read stdout and report progress, each line is the percentage and the
+	// video's duration in seconds
+	var duration float64
 	scanner := bufio.NewScanner(stdoutPipe)
 	for scanner.Scan() {
-		line := scanner.Text()
-		// Only print if it's a progress percentage
-		if strings.HasSuffix(line, "%") {
-			fmt.Printf("\rDownloading: %s", line)
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || !strings.HasSuffix(fields[0], "%") {
+			continue
+		}
+		if len(fields) > 1 {
+			if seconds, err := strconv.ParseFloat(fields[1], 64); err == nil {
+				duration = seconds
+			}
+		}
+		if percent, err := strconv.ParseFloat(strings.TrimSuffix(fields[0], "%"), 64); err == nil && progress != nil {
+			progress(percent)
 		}
 	}
 
@@ -769,9 +1538,9 @@ func (c *Client) DownloadVideo(videoID string) error {
 	if err := cmd.Wait(); err != nil {
 		return fmt.Errorf("error during download: %w", err)
 	}
-
-	// Clear the progress line
-	fmt.Print("\r")
+	
+	// Downloads are the best format, estimated like streaming it
+	c.recordDataUsage(estimateBytes(formatKbps(format, false), time.Duration(duration*float64(time.Second))))
 
 	return nil
 } 
\ No newline at end of file
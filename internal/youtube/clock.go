@@ -0,0 +1,37 @@
+package youtube
+
+import "time"
+
+// Clock tells the time. The client reads the time through it rather than
+// calling time.Now directly, so time-based logic such as cache expiry, watch
+// timestamps and snoozes can be run at a fixed time.
+type Clock interface {
+	Now() time.Time
+}
+
+// RealClock is the Clock that tells the actual time
+type RealClock struct{}
+
+// Now returns the current time
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// SetClock replaces the clock the client reads the time from, nil restores
+// the real time
+func (c *Client) SetClock(clock Clock) {
+	if clock == nil {
+		clock = RealClock{}
+	}
+	c.clock = clock
+}
+
+// Clock returns the clock the client reads the time from
+func (c *Client) Clock() Clock {
+	return c.clock
+}
+
+// now returns the time according to the client's clock
+func (c *Client) now() time.Time {
+	return c.clock.Now()
+}
@@ -0,0 +1,106 @@
+package youtube
+
+import (
+	"errors"
+	"strings"
+)
+
+// MPVArgs returns the arguments the player would be started with to play
+// the video, built from the config, the active play profile and the
+// channel's start offset
+func (c *Client) MPVArgs(video Video) []string {
+	return c.playerCommandArgs(video, QualityDefault, c.startOffset(video))
+}
+
+// PlayVideoWithArgs plays the video in the player with the given arguments
+// instead of the ones built from the config. A quality other than the default
+// is added to MPV's arguments, overriding any format in them. A configured
+// player's arguments are used as they are.
+func (c *Client) PlayVideoWithArgs(video Video, args []string, quality StreamQuality) error {
+	if quality != QualityDefault && c.player.Command == "" {
+		args = append(append([]string(nil), args...), qualityMPVArgs(quality, 0)...)
+	}
+	cmd, err := c.commandWithArgs(args)
+	if err != nil {
+		return err
+	}
+	_, err = c.players.start(video, cmd)
+	return err
+}
+
+// QuoteArgs joins the arguments into a single line a shell would split back
+// into the same arguments
+func QuoteArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = quoteArg(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// quoteArg single-quotes an argument if it contains anything a shell would
+// interpret
+func quoteArg(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`*?[]()<>|&;#~!{}") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// SplitArgs splits a command line into arguments the way a shell would,
+// honoring single quotes, double quotes and backslash escapes
+func SplitArgs(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+			inArg = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, errors.New("unterminated quote")
+	}
+	if escaped {
+		return nil, errors.New("trailing backslash")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,165 @@
+package youtube
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dataUsageDays is how many days of data usage are kept on disk
+const dataUsageDays = 31
+
+// audioKbps is the rough bitrate of YouTube's audio streams
+const audioKbps = 130
+
+// assumedHeight is the resolution estimated for formats without a height
+// cap, since most uploads top out at 1080p
+const assumedHeight = 1080
+
+// videoKbps are rough average bitrates of YouTube's video streams by height.
+// They only need to be good enough to keep an eye on a data cap.
+var videoKbps = []struct {
+	height int
+	kbps   int
+}{
+	{144, 100},
+	{240, 250},
+	{360, 500},
+	{480, 1000},
+	{720, 2500},
+	{1080, 4500},
+	{1440, 9000},
+	{2160, 18000},
+}
+
+// formatHeightPattern finds the height cap in a yt-dlp format selector
+var formatHeightPattern = regexp.MustCompile(`height<=\??(\d+)`)
+
+// dataUsage is the estimated data used per local day
+type dataUsage struct {
+	Days map[string]int64 `json:"days"` // YYYY-MM-DD to bytes
+}
+
+// formatKbps estimates the bitrate of a yt-dlp format selector
+func formatKbps(format string, audioOnly bool) int {
+	if audioOnly {
+		return audioKbps
+	}
+	height := assumedHeight
+	if match := formatHeightPattern.FindStringSubmatch(format); match != nil {
+		height, _ = strconv.Atoi(match[1])
+	}
+	for _, rate := range videoKbps {
+		if height <= rate.height {
+			return rate.kbps + audioKbps
+		}
+	}
+	return videoKbps[len(videoKbps)-1].kbps + audioKbps
+}
+
+// mpvKbps estimates the bitrate MPV streams at with the given arguments
+func mpvKbps(args []string) int {
+	format := ""
+	audioOnly := false
+	for _, arg := range args {
+		switch {
+		case arg == "--no-video":
+			audioOnly = true
+		case strings.HasPrefix(arg, "--ytdl-format="):
+			format = strings.TrimPrefix(arg, "--ytdl-format=")
+		}
+	}
+	return formatKbps(format, audioOnly)
+}
+
+// estimateBytes estimates the data used streaming at kbps for the duration
+func estimateBytes(kbps int, duration time.Duration) int64 {
+	return int64(float64(kbps) * 1000 / 8 * duration.Seconds())
+}
+
+// getDataUsagePath returns the path to the data usage file
+func (c *Client) getDataUsagePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "data_usage.json"), nil
+}
+
+// loadDataUsage reads the data usage file, empty if it doesn't exist yet
+func (c *Client) loadDataUsage() (dataUsage, error) {
+	usage := dataUsage{Days: make(map[string]int64)}
+	usagePath, err := c.getDataUsagePath()
+	if err != nil {
+		return usage, err
+	}
+
+	data, err := os.ReadFile(usagePath)
+	if os.IsNotExist(err) {
+		return usage, nil
+	} else if err != nil {
+		return usage, err
+	}
+
+	if err := json.Unmarshal(data, &usage); err != nil {
+		return dataUsage{Days: make(map[string]int64)}, fmt.Errorf("error parsing data usage: %w", err)
+	}
+	if usage.Days == nil {
+		usage.Days = make(map[string]int64)
+	}
+	return usage, nil
+}
+
+// recordDataUsage adds the estimated bytes of a playback or download to the
+// session and today's totals. Players exit and downloads finish from
+// different goroutines, so the totals are guarded by dataMu.
+func (c *Client) recordDataUsage(bytes int64) {
+	c.dataMu.Lock()
+	defer c.dataMu.Unlock()
+
+	c.sessionData += bytes
+
+	// A corrupt file starts over rather than losing today's usage
+	usage, _ := c.loadDataUsage()
+	now := c.now()
+	usage.Days[now.Format("2006-01-02")] += bytes
+	oldest := now.AddDate(0, 0, -dataUsageDays).Format("2006-01-02")
+	for day := range usage.Days {
+		if day < oldest {
+			delete(usage.Days, day)
+		}
+	}
+
+	usagePath, err := c.getDataUsagePath()
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(usage)
+	if err != nil {
+		return
+	}
+	// Best effort, the usage is only an estimate
+	_ = os.WriteFile(usagePath, data, 0644)
+}
+
+// recordPlayback estimates the data a player used from its stream format and
+// how long it ran
+func (c *Client) recordPlayback(player *Player) {
+	c.recordDataUsage(estimateBytes(mpvKbps(player.cmd.Args[1:]), time.Since(player.StartedAt)))
+}
+
+// DataUsage returns the estimated data used by playback and downloads this
+// session and today
+func (c *Client) DataUsage() (session, today int64) {
+	c.dataMu.Lock()
+	defer c.dataMu.Unlock()
+
+	usage, _ := c.loadDataUsage()
+	return c.sessionData, usage.Days[c.now().Format("2006-01-02")]
+}
@@ -0,0 +1,118 @@
+package youtube
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// dismissedVideo is a video hidden from the feed as stored on disk. The whole
+// video is kept so dismissed videos can be listed and restored.
+type dismissedVideo struct {
+	Video       Video     `json:"video"`
+	DismissedAt time.Time `json:"dismissed_at"`
+}
+
+// getDismissedPath returns the path to the dismissed videos file
+func (c *Client) getDismissedPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "dismissed.json"), nil
+}
+
+// loadDismissed reads the dismissed videos, keyed by video ID
+func (c *Client) loadDismissed() (map[string]dismissedVideo, error) {
+	dismissed := make(map[string]dismissedVideo)
+
+	dismissedPath, err := c.getDismissedPath()
+	if err != nil {
+		return dismissed, err
+	}
+
+	data, err := os.ReadFile(dismissedPath)
+	if os.IsNotExist(err) {
+		return dismissed, nil
+	}
+	if err != nil {
+		return dismissed, err
+	}
+	if err := json.Unmarshal(data, &dismissed); err != nil {
+		return make(map[string]dismissedVideo), fmt.Errorf("error parsing dismissed videos: %w", err)
+	}
+	return dismissed, nil
+}
+
+// saveDismissed writes the dismissed videos
+func (c *Client) saveDismissed(dismissed map[string]dismissedVideo) error {
+	dismissedPath, err := c.getDismissedPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dismissedPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(dismissed)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dismissedPath, data, 0644)
+}
+
+// DismissVideo hides a video from the feed for good, without marking it watched
+func (c *Client) DismissVideo(video Video) error {
+	dismissed, err := c.loadDismissed()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := dismissed[video.ID]; ok {
+		return nil
+	}
+	dismissed[video.ID] = dismissedVideo{Video: video, DismissedAt: c.now()}
+	return c.saveDismissed(dismissed)
+}
+
+// RestoreVideo brings a dismissed video back to the feed
+func (c *Client) RestoreVideo(videoID string) error {
+	dismissed, err := c.loadDismissed()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := dismissed[videoID]; !ok {
+		return nil
+	}
+	delete(dismissed, videoID)
+	return c.saveDismissed(dismissed)
+}
+
+// GetDismissed returns the dismissed videos, most recently dismissed first
+func (c *Client) GetDismissed() ([]Video, error) {
+	dismissed, err := c.loadDismissed()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]dismissedVideo, 0, len(dismissed))
+	for _, entry := range dismissed {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].DismissedAt.After(entries[j].DismissedAt)
+	})
+
+	videos := make([]Video, len(entries))
+	for i, entry := range entries {
+		videos[i] = entry.Video
+	}
+	return videos, nil
+}
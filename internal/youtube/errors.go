@@ -0,0 +1,122 @@
+package youtube
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+// APINotEnabledError is returned when the API key's Google Cloud project
+// doesn't have the YouTube Data API v3 enabled
+type APINotEnabledError struct {
+	Project string // Project number, if it could be determined
+}
+
+// projectPattern extracts the project number from Google's error message
+var projectPattern = regexp.MustCompile(`projects?[ /](\d+)`)
+
+// Error implements the error interface
+func (e *APINotEnabledError) Error() string {
+	return fmt.Sprintf("the YouTube Data API v3 is not enabled for your Google Cloud project. "+
+		"Enable it at %s, wait a few minutes, and try again", e.EnableURL())
+}
+
+// EnableURL returns the Cloud Console page where the API can be enabled
+func (e *APINotEnabledError) EnableURL() string {
+	url := "https://console.developers.google.com/apis/api/youtube.googleapis.com/overview"
+	if e.Project != "" {
+		url += "?project=" + e.Project
+	}
+	return url
+}
+
+// apiError translates well-known Google API errors into errors with
+// actionable messages, returning any other error unchanged
+func apiError(err error) error {
+	var gErr *googleapi.Error
+	if !errors.As(err, &gErr) {
+		return err
+	}
+
+	if isServiceDisabled(gErr) {
+		notEnabled := &APINotEnabledError{}
+		if match := projectPattern.FindStringSubmatch(gErr.Message + " " + gErr.Body); match != nil {
+			notEnabled.Project = match[1]
+		}
+		return notEnabled
+	}
+
+	if isQuotaExceeded(gErr) {
+		return &QuotaExceededError{ResetAt: NextQuotaReset(time.Now())}
+	}
+
+	return err
+}
+
+// isQuotaExceeded reports whether the error is a quotaExceeded / dailyLimitExceeded error
+func isQuotaExceeded(gErr *googleapi.Error) bool {
+	for _, item := range gErr.Errors {
+		if item.Reason == "quotaExceeded" || item.Reason == "dailyLimitExceeded" {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrOffline is returned when YouTube can't be reached and there are no cached videos to fall back on
+var ErrOffline = errors.New("no network connection and no cached videos")
+
+// isNetworkError reports whether err is a connectivity failure, such as a
+// failed DNS lookup or a refused connection, rather than an error from the API
+func isNetworkError(err error) bool {
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	return errors.As(err, &dnsErr) || errors.As(err, &opErr)
+}
+
+// isRateLimited reports whether err is YouTube asking us to slow down,
+// either a 429 or a 403 rateLimitExceeded / userRateLimitExceeded error
+func isRateLimited(err error) bool {
+	var gErr *googleapi.Error
+	if !errors.As(err, &gErr) {
+		return false
+	}
+	if gErr.Code == 429 {
+		return true
+	}
+	for _, item := range gErr.Errors {
+		if item.Reason == "rateLimitExceeded" || item.Reason == "userRateLimitExceeded" {
+			return true
+		}
+	}
+	return false
+}
+
+// isServiceDisabled reports whether the error is an accessNotConfigured / SERVICE_DISABLED error
+func isServiceDisabled(gErr *googleapi.Error) bool {
+	for _, item := range gErr.Errors {
+		if item.Reason == "accessNotConfigured" {
+			return true
+		}
+	}
+	return strings.Contains(gErr.Body, "SERVICE_DISABLED")
+}
+
+// isPlaylistNotFound reports whether err is a 404 playlistNotFound error
+func isPlaylistNotFound(err error) bool {
+	var gErr *googleapi.Error
+	if !errors.As(err, &gErr) || gErr.Code != 404 {
+		return false
+	}
+	for _, item := range gErr.Errors {
+		if item.Reason == "playlistNotFound" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,277 @@
+package youtube
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/fabean/ytviewer/internal/config"
+)
+
+// ImportResult summarizes a subscription import
+type ImportResult struct {
+	Added    int      // Channels newly subscribed to
+	Existing int      // Channels that were already subscribed
+	Skipped  []string // Channels that couldn't be resolved, by URL or name
+}
+
+// channelRef is a channel as listed in another app's export, by ID or URL
+type channelRef struct {
+	ID   string
+	URL  string
+	Name string
+}
+
+// newPipeExport is the subscriptions export of NewPipe
+type newPipeExport struct {
+	Subscriptions []struct {
+		ServiceID int    `json:"service_id"`
+		URL       string `json:"url"`
+		Name      string `json:"name"`
+	} `json:"subscriptions"`
+}
+
+// newPipeYouTube is NewPipe's service ID for YouTube
+const newPipeYouTube = 0
+
+// ImportNewPipe subscribes to the YouTube channels in a NewPipe subscriptions export
+func (c *Client) ImportNewPipe(path string) (ImportResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ImportResult{}, fmt.Errorf("error reading NewPipe export: %w", err)
+	}
+
+	var export newPipeExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return ImportResult{}, fmt.Errorf("error parsing NewPipe export: %w", err)
+	}
+
+	var refs []channelRef
+	for _, sub := range export.Subscriptions {
+		// NewPipe also follows channels on SoundCloud, PeerTube and others
+		if sub.ServiceID != newPipeYouTube {
+			continue
+		}
+		refs = append(refs, channelRef{URL: sub.URL, Name: sub.Name})
+	}
+	return c.importChannels(refs)
+}
+
+// freeTubeProfile is a profile in FreeTube's profiles.db or subscriptions export
+type freeTubeProfile struct {
+	Subscriptions []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"subscriptions"`
+}
+
+// ImportFreeTube subscribes to the channels in a FreeTube profiles.db or
+// subscriptions export, merging the channels of every profile
+func (c *Client) ImportFreeTube(path string) (ImportResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ImportResult{}, fmt.Errorf("error reading FreeTube export: %w", err)
+	}
+
+	// FreeTube stores one JSON profile per line
+	var refs []channelRef
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var profile freeTubeProfile
+		if err := json.Unmarshal(line, &profile); err != nil {
+			return ImportResult{}, fmt.Errorf("error parsing FreeTube export: %w", err)
+		}
+		for _, sub := range profile.Subscriptions {
+			refs = append(refs, channelRef{ID: sub.ID, Name: sub.Name})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return ImportResult{}, fmt.Errorf("error reading FreeTube export: %w", err)
+	}
+	return c.importChannels(refs)
+}
+
+// importChannels resolves the channels to IDs and adds the ones not yet
+// subscribed to, saving the subscriptions once
+func (c *Client) importChannels(refs []channelRef) (ImportResult, error) {
+	var result ImportResult
+
+	// Refuse the import before resolving channels if it can't be saved
+	if err := config.CheckWritable(); err != nil {
+		return result, err
+	}
+
+	subscribed := make(map[string]bool, len(c.subscribedChannels))
+	for _, id := range c.subscribedChannels {
+		subscribed[id] = true
+	}
+
+	var candidates []string
+	for _, ref := range refs {
+		channelID, err := c.resolveChannelRef(ref)
+		var quotaErr *QuotaExceededError
+		if errors.As(err, &quotaErr) || isNetworkError(err) {
+			// Every other lookup would fail too
+			return result, err
+		}
+		if err != nil {
+			result.Skipped = append(result.Skipped, ref.label())
+			continue
+		}
+		if subscribed[channelID] {
+			result.Existing++
+			continue
+		}
+		subscribed[channelID] = true
+		candidates = append(candidates, channelID)
+	}
+	if len(candidates) == 0 {
+		return result, nil
+	}
+
+	// Only subscribe to channels that still exist, in batches of 50
+	channels, err := c.listChannelsByIDs(context.Background(), []string{"snippet"}, candidates)
+	if err != nil {
+		return result, err
+	}
+	var added []string
+	for _, channel := range channels {
+		c.cacheChannelName(channel.Id, channel.Snippet.Title)
+		c.subscribedChannels = append(c.subscribedChannels, channel.Id)
+		added = append(added, channel.Id)
+		result.Added++
+	}
+	result.Skipped = append(result.Skipped, missingChannelIDs(candidates, channels)...)
+
+	if result.Added > 0 {
+		c.ClearSubscriptionCache()
+		if err := c.saveSubscriptions(added, nil); err != nil {
+			return result, fmt.Errorf("error saving config: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// label describes the channel for the list of skipped channels
+func (r channelRef) label() string {
+	switch {
+	case r.Name != "":
+		return r.Name
+	case r.URL != "":
+		return r.URL
+	default:
+		return r.ID
+	}
+}
+
+// resolveChannelRef returns the channel ID of a channel given by ID or by a
+// /channel/, /user/ or /@handle URL. Legacy /c/ custom URLs can't be looked
+// up through the API.
+func (c *Client) resolveChannelRef(ref channelRef) (string, error) {
+	if ref.ID != "" {
+		return ref.ID, nil
+	}
+
+	parts, err := channelURLPath(ref.URL)
+	if err != nil {
+		return "", err
+	}
+	if err := checkQueryValue("channel URL", ref.URL); err != nil {
+		return "", err
+	}
+
+	call := c.service.Channels.List([]string{"id"})
+	switch {
+	case len(parts) >= 2 && parts[0] == "channel":
+		return parts[1], nil
+	case len(parts) >= 2 && parts[0] == "user":
+		call = call.ForUsername(parts[1])
+	case strings.HasPrefix(parts[0], "@"):
+		call = call.ForHandle(parts[0])
+	case len(parts) >= 2 && parts[0] == "c":
+		return "", fmt.Errorf("legacy /c/ URLs like %q can't be looked up through the API, use the channel's @handle or /channel/ URL instead", ref.URL)
+	default:
+		return "", fmt.Errorf("unsupported channel URL %q, expected a /channel/, /user/ or /@handle URL", ref.URL)
+	}
+
+	c.useQuota(quotaCostList)
+	response, err := call.Do()
+	if err != nil {
+		return "", fmt.Errorf("error resolving channel: %w", apiError(err))
+	}
+	if len(response.Items) == 0 {
+		if parts[0] == "user" {
+			return "", fmt.Errorf("no channel has the legacy username %q, it may have been retired, try the channel's @handle or /channel/ URL instead", parts[1])
+		}
+		return "", fmt.Errorf("channel not found: %q", ref.URL)
+	}
+	return response.Items[0].Id, nil
+}
+
+// channelIDPattern matches a channel ID, "UC" followed by 22 characters
+var channelIDPattern = regexp.MustCompile(`^UC[A-Za-z0-9_-]{22}$`)
+
+// looksLikeChannel reports whether the input is a channel ID, @handle or
+// YouTube channel URL, without looking it up
+func looksLikeChannel(input string) bool {
+	if channelIDPattern.MatchString(input) {
+		return true
+	}
+	if input == "" || strings.ContainsAny(input, " \t\n") {
+		return false
+	}
+	if strings.HasPrefix(input, "@") {
+		return len(input) > 1
+	}
+
+	raw := input
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+	if host != "youtube.com" {
+		return false
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch {
+	case strings.HasPrefix(parts[0], "@"):
+		return len(parts[0]) > 1
+	case parts[0] == "channel", parts[0] == "user", parts[0] == "c":
+		return len(parts) > 1 && parts[1] != ""
+	}
+	return false
+}
+
+// channelURLPath splits the path of a channel URL into its segments. The
+// scheme and host are optional, so "youtube.com/user/name" and a bare
+// "@handle" work as well as full URLs.
+func channelURLPath(input string) ([]string, error) {
+	if strings.HasPrefix(input, "@") {
+		input = "youtube.com/" + input
+	}
+	if !strings.Contains(input, "://") {
+		input = "https://" + input
+	}
+	u, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel URL %q", input)
+	}
+	return strings.Split(strings.Trim(u.Path, "/"), "/"), nil
+}
@@ -0,0 +1,84 @@
+package youtube
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// minFetchConcurrency and maxFetchConcurrency bound how many channels are fetched at once
+	minFetchConcurrency = 1
+	maxFetchConcurrency = 16
+
+	// initialFetchConcurrency is where the limit starts before anything has been observed
+	initialFetchConcurrency = 2
+
+	// slowRequestThreshold is the latency above which a request no longer
+	// counts towards raising the limit
+	slowRequestThreshold = time.Second
+)
+
+// adaptiveLimiter bounds how many requests run at once. The limit grows by
+// one after a full round of fast, successful requests and is halved whenever
+// YouTube rate limits us, so it settles wherever the network and API allow.
+type adaptiveLimiter struct {
+	mu        sync.Mutex
+	cond      *sync.Cond
+	limit     int
+	inFlight  int
+	successes int // Fast successes since the limit last changed
+}
+
+// newAdaptiveLimiter creates a limiter starting at initialFetchConcurrency
+func newAdaptiveLimiter() *adaptiveLimiter {
+	l := &adaptiveLimiter{limit: initialFetchConcurrency}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// acquire blocks until a request may start
+func (l *adaptiveLimiter) acquire() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.inFlight >= l.limit {
+		l.cond.Wait()
+	}
+	l.inFlight++
+}
+
+// release records how a request went and lets the next one start
+func (l *adaptiveLimiter) release(latency time.Duration, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+
+	switch {
+	case isRateLimited(err):
+		if l.limit > minFetchConcurrency {
+			l.limit = max(minFetchConcurrency, l.limit/2)
+			slog.Debug("rate limited, lowering fetch concurrency", "concurrency", l.limit)
+		}
+		l.successes = 0
+	case err != nil:
+		// Other failures say nothing about how hard we can push
+	case latency > slowRequestThreshold:
+		l.successes = 0
+	default:
+		l.successes++
+		if l.successes >= l.limit && l.limit < maxFetchConcurrency {
+			l.limit++
+			l.successes = 0
+			slog.Debug("raising fetch concurrency", "concurrency", l.limit)
+		}
+	}
+
+	l.cond.Broadcast()
+}
+
+// Limit returns the current concurrency limit
+func (l *adaptiveLimiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
@@ -0,0 +1,54 @@
+package youtube
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// standardHeights are the video heights YouTube streams at
+var standardHeights = []int{144, 240, 360, 480, 720, 1080, 1440, 2160, 4320}
+
+// parseMaxResolution parses mpv_options.max_resolution, a standard video
+// height such as "720", "1080" or "2160", optionally ending in "p"
+func parseMaxResolution(value string) (int, error) {
+	height, err := strconv.Atoi(strings.TrimSuffix(value, "p"))
+	if err == nil {
+		for _, standard := range standardHeights {
+			if height == standard {
+				return height, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("invalid max_resolution %q, expected a video height such as 720, 1080, 1440 or 2160", value)
+}
+
+// cacheSeconds is how far ahead MPV buffers when a cache size is set. MPV
+// reads ahead until either limit is reached, and with its default of an hour
+// a long video fills the whole cache, data wasted if the video is closed.
+const cacheSeconds = 300
+
+// MaxResolution returns the highest resolution streamed by default, from
+// mpv_options.max_resolution
+func (c *Client) MaxResolution() int {
+	return c.maxHeight
+}
+
+// optionsMPVArgs returns the MPV arguments for hardware decoding and the
+// cache from mpv_options. A play profile's cache size replaces the one from
+// mpv_options.
+func (c *Client) optionsMPVArgs() []string {
+	var args []string
+	if c.mpvOptions.HardwareAccel {
+		args = append(args, "--hwdec=auto")
+	}
+	cacheSize := c.mpvOptions.CacheSize
+	if c.playProfile.CacheSize != "" {
+		cacheSize = c.playProfile.CacheSize
+	}
+	if cacheSize != "" {
+		args = append(args, "--cache=yes", "--demuxer-max-bytes="+cacheSize,
+			"--cache-secs="+strconv.Itoa(cacheSeconds))
+	}
+	return args
+}
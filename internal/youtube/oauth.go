@@ -0,0 +1,250 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/api/youtube/v3"
+
+	"github.com/fabean/ytviewer/internal/config"
+)
+
+// defaultTokenFile is where the OAuth token is saved in the config directory,
+// and legacyTokenFile where it was saved before oauth_token_path was added
+const (
+	defaultTokenFile = "token.json"
+	legacyTokenFile  = "oauth_token.json"
+)
+
+// ErrOAuthNotConfigured is returned for features that act on the user's own
+// YouTube account when no OAuth client is configured
+var ErrOAuthNotConfigured = errors.New("set oauth_client_id and oauth_client_secret in the config to use your YouTube account")
+
+// authorizeTimeout is how long to wait for the user to grant access in the browser
+const authorizeTimeout = 3 * time.Minute
+
+// tokenRefreshTimeout bounds each request renewing the access token
+const tokenRefreshTimeout = 30 * time.Second
+
+// SetOAuthCredentials sets the OAuth client used for features that act on
+// the user's own account, such as syncing subscriptions and creating
+// playlists. The API key can't be used for those.
+func (c *Client) SetOAuthCredentials(credentials config.OAuthCredentials) {
+	c.oauth = credentials
+}
+
+// OAuthConfigured reports whether an OAuth client is set, so features using
+// the user's account are available
+func (c *Client) OAuthConfigured() bool {
+	return c.oauth.Configured()
+}
+
+// oauthConfig returns the OAuth configuration for a desktop app, redirecting
+// back to the given loopback address
+func (c *Client) oauthConfig(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.oauth.ClientID,
+		ClientSecret: c.oauth.ClientSecret,
+		Endpoint:     endpoints.Google,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{youtube.YoutubeScope},
+	}
+}
+
+// accountService returns a YouTube service authorized to act on the user's
+// account. The first time, the browser is opened to grant access, and the
+// token is saved so later sessions don't have to ask again. A saved token
+// that can no longer be renewed, e.g. because access was revoked, is
+// replaced by asking again.
+func (c *Client) accountService(ctx context.Context) (*youtube.Service, error) {
+	if !c.oauth.Configured() {
+		return nil, ErrOAuthNotConfigured
+	}
+
+	tokenPath, err := c.oauthTokenPath()
+	if err != nil {
+		return nil, err
+	}
+	token, err := loadOAuthToken(tokenPath)
+	if err != nil {
+		return c.reauthorize(ctx)
+	}
+
+	// Renew an expired token up front, so a revoked one is caught here
+	tokens := c.tokenSource(ctx, token)
+	if _, err := tokens.Token(); err != nil {
+		if isUnauthorized(err) {
+			return c.reauthorize(ctx)
+		}
+		return nil, fmt.Errorf("error renewing access to your account: %w", err)
+	}
+	return newAccountService(ctx, tokens)
+}
+
+// reauthorize deletes the saved token and runs the authorization in the
+// browser again, for when the API no longer accepts the saved access
+func (c *Client) reauthorize(ctx context.Context) (*youtube.Service, error) {
+	tokenPath, err := c.oauthTokenPath()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Remove(tokenPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	token, err := c.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := saveOAuthToken(tokenPath, token); err != nil {
+		return nil, err
+	}
+	return newAccountService(ctx, c.tokenSource(ctx, token))
+}
+
+// tokenSource returns the token, renewed when it expires
+func (c *Client) tokenSource(ctx context.Context, token *oauth2.Token) oauth2.TokenSource {
+	// Token refreshes use the context's client, bounded so a hung connection can't stall them
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: tokenRefreshTimeout})
+	return c.oauthConfig("").TokenSource(ctx, token)
+}
+
+// newAccountService creates a YouTube service acting with the tokens
+func newAccountService(ctx context.Context, tokens oauth2.TokenSource) (*youtube.Service, error) {
+	service, err := youtube.NewService(ctx, option.WithTokenSource(tokens))
+	if err != nil {
+		return nil, fmt.Errorf("error creating YouTube service: %w", err)
+	}
+	return service, nil
+}
+
+// isUnauthorized reports whether the saved access to the user's account was
+// refused, either when renewing the token or by the API with a 401
+func isUnauthorized(err error) bool {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		// Server errors don't say anything about the token
+		return retrieveErr.Response == nil || retrieveErr.Response.StatusCode < http.StatusInternalServerError
+	}
+	var gErr *googleapi.Error
+	return errors.As(err, &gErr) && gErr.Code == http.StatusUnauthorized
+}
+
+// authorize runs the OAuth flow for installed apps: the consent page opens
+// in the browser, which redirects back to a server on a loopback port with
+// the code that's exchanged for a token
+func (c *Client) authorize(ctx context.Context) (*oauth2.Token, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("error starting the authorization callback: %w", err)
+	}
+	defer listener.Close()
+
+	config := c.oauthConfig("http://" + listener.Addr().String())
+	state := uuid.NewString()
+
+	codes := make(chan string, 1)
+	failures := make(chan error, 1)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case query.Get("state") != state:
+			http.Error(w, "Unexpected authorization response", http.StatusBadRequest)
+			return
+		case query.Get("error") != "":
+			fmt.Fprintln(w, "Access was not granted, you can close this tab.")
+			select {
+			case failures <- fmt.Errorf("access was not granted: %s", query.Get("error")):
+			default:
+			}
+			return
+		}
+		fmt.Fprintln(w, "ytviewer can now use your YouTube account, you can close this tab.")
+		select {
+		case codes <- query.Get("code"):
+		default:
+			// Only the first response counts, e.g. if the page is reloaded
+		}
+	})}
+	go server.Serve(listener)
+	defer server.Close()
+
+	if err := openURL(config.AuthCodeURL(state, oauth2.AccessTypeOffline)); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, authorizeTimeout)
+	defer cancel()
+	select {
+	case code := <-codes:
+		token, err := config.Exchange(ctx, code)
+		if err != nil {
+			return nil, fmt.Errorf("error getting access to your account: %w", err)
+		}
+		return token, nil
+	case err := <-failures:
+		return nil, err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("gave up waiting for access to be granted in the browser")
+	}
+}
+
+// oauthTokenPath returns the path of the saved OAuth token, oauth_token_path
+// or token.json in the config directory. A token saved under its old name is
+// moved to token.json so access doesn't have to be granted again.
+func (c *Client) oauthTokenPath() (string, error) {
+	if c.oauth.TokenPath != "" {
+		return c.oauth.TokenPath, nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	configDir := filepath.Join(homeDir, ".config", "ytviewer")
+	path := filepath.Join(configDir, defaultTokenFile)
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		_ = os.Rename(filepath.Join(configDir, legacyTokenFile), path)
+	}
+	return path, nil
+}
+
+// loadOAuthToken reads the saved OAuth token
+func loadOAuthToken(path string) (*oauth2.Token, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var token oauth2.Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("error parsing OAuth token: %w", err)
+	}
+	return &token, nil
+}
+
+// saveOAuthToken saves the OAuth token, readable only by the user since it
+// grants access to their account
+func saveOAuthToken(path string, token *oauth2.Token) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("error saving OAuth token: %w", err)
+	}
+	return nil
+}
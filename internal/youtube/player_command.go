@@ -0,0 +1,100 @@
+package youtube
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/fabean/ytviewer/internal/config"
+)
+
+// defaultPlayer is the player used when none is configured
+const defaultPlayer = "mpv"
+
+// defaultPlayerArgs are the arguments of a configured player without an args_template
+const defaultPlayerArgs = "{url}"
+
+// SetPlayer sets the media player videos are played with instead of MPV. A
+// nil player or an empty command keeps MPV.
+func (c *Client) SetPlayer(player *config.Player) error {
+	if player == nil || player.Command == "" {
+		c.player = config.Player{}
+		c.playerArgs = nil
+		return nil
+	}
+
+	template := player.ArgsTemplate
+	if template == "" {
+		template = defaultPlayerArgs
+	}
+	args, err := SplitArgs(template)
+	if err != nil {
+		return fmt.Errorf("invalid player args_template %q: %w", player.ArgsTemplate, err)
+	}
+	c.player = *player
+	c.playerArgs = args
+	return nil
+}
+
+// PlayerName returns the command videos are played with
+func (c *Client) PlayerName() string {
+	if c.player.Command != "" {
+		return c.player.Command
+	}
+	return defaultPlayer
+}
+
+// playerCommand builds the command playing a video from start seconds in
+func (c *Client) playerCommand(video Video, quality StreamQuality, start int) (*exec.Cmd, error) {
+	return c.commandWithArgs(c.playerCommandArgs(video, quality, start))
+}
+
+// playerCommandArgs returns the arguments of the configured player with the
+// placeholders expanded, or the MPV arguments built from the config
+func (c *Client) playerCommandArgs(video Video, quality StreamQuality, start int) []string {
+	if c.player.Command == "" {
+		return c.mpvArgs(video, quality, start)
+	}
+
+	replacer := strings.NewReplacer(
+		"{url}", video.URL(),
+		"{id}", video.ID,
+		"{title}", video.Title,
+		"{start}", strconv.Itoa(start),
+		"{resolution}", c.streamResolution(video.ChannelID, quality),
+	)
+	args := make([]string, len(c.playerArgs))
+	for i, arg := range c.playerArgs {
+		args[i] = replacer.Replace(arg)
+	}
+	return args
+}
+
+// streamResolution describes the resolution a channel's video streams at
+// for the {resolution} placeholder, in the channel_resolution values: a
+// height such as "1080", "audio" or "best"
+func (c *Client) streamResolution(channelID string, quality StreamQuality) string {
+	quality, maxHeight := c.streamQuality(channelID, quality)
+	switch quality {
+	case QualityLow:
+		return "360"
+	case QualityAudioOnly:
+		return "audio"
+	case QualityBest:
+		return "best"
+	default:
+		return strconv.Itoa(maxHeight)
+	}
+}
+
+// commandWithArgs returns the command running the player with the
+// arguments, or an error saying so when the player isn't installed
+func (c *Client) commandWithArgs(args []string) (*exec.Cmd, error) {
+	name := c.PlayerName()
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return nil, fmt.Errorf("player %q was not found on the PATH, install it or set player.command in the config", name)
+	}
+	return exec.Command(path, args...), nil
+}
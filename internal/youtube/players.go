@@ -0,0 +1,109 @@
+package youtube
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Player is a running MPV process started by ytviewer
+type Player struct {
+	PID       int
+	Video     Video
+	StartedAt time.Time
+
+	cmd  *exec.Cmd
+	done chan struct{} // Closed once the process has exited
+	err  error         // Exit error, set before done is closed
+}
+
+// playerRegistry tracks the MPV processes that are still running
+type playerRegistry struct {
+	mu      sync.Mutex
+	players map[int]*Player
+	exits   chan Video           // Notified whenever a player exits
+	onExit  func(player *Player) // Called when a player exits, if set
+}
+
+// newPlayerRegistry creates an empty player registry
+func newPlayerRegistry() *playerRegistry {
+	return &playerRegistry{
+		players: make(map[int]*Player),
+		exits:   make(chan Video, 16),
+	}
+}
+
+// start starts the command, tracking it until it exits
+func (r *playerRegistry) start(video Video, cmd *exec.Cmd) (*Player, error) {
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("error starting %s: %w", filepath.Base(cmd.Path), err)
+	}
+
+	player := &Player{
+		PID:       cmd.Process.Pid,
+		Video:     video,
+		StartedAt: time.Now(),
+		cmd:       cmd,
+		done:      make(chan struct{}),
+	}
+	r.mu.Lock()
+	r.players[player.PID] = player
+	r.mu.Unlock()
+
+	// Reap the process once it exits so it doesn't linger as a zombie
+	go func() {
+		player.err = cmd.Wait()
+		r.mu.Lock()
+		delete(r.players, player.PID)
+		r.mu.Unlock()
+		close(player.done)
+		if r.onExit != nil {
+			r.onExit(player)
+		}
+
+		// Nobody may be listening, never block the reaper
+		select {
+		case r.exits <- video:
+		default:
+		}
+	}()
+	return player, nil
+}
+
+// Players returns the running MPV processes, oldest first
+func (c *Client) Players() []Player {
+	c.players.mu.Lock()
+	defer c.players.mu.Unlock()
+
+	players := make([]Player, 0, len(c.players.players))
+	for _, player := range c.players.players {
+		players = append(players, Player{PID: player.PID, Video: player.Video, StartedAt: player.StartedAt})
+	}
+	sort.Slice(players, func(i, j int) bool {
+		return players[i].StartedAt.Before(players[j].StartedAt)
+	})
+	return players
+}
+
+// PlayerExits returns a channel that receives the video of each player that exits
+func (c *Client) PlayerExits() <-chan Video {
+	return c.players.exits
+}
+
+// StopPlayer kills a running MPV process started by ytviewer
+func (c *Client) StopPlayer(pid int) error {
+	c.players.mu.Lock()
+	player, ok := c.players.players[pid]
+	c.players.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("player %d is no longer running", pid)
+	}
+
+	if err := player.cmd.Process.Kill(); err != nil {
+		return fmt.Errorf("error stopping player: %w", err)
+	}
+	return nil
+}
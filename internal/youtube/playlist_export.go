@@ -0,0 +1,101 @@
+package youtube
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// quotaCostInsert is the quota cost of an insert call such as
+// playlists.insert or playlistItems.insert
+const quotaCostInsert = 50
+
+// MaxPlaylistVideos is the most videos CreatePlaylistFromVideos adds. Each one
+// costs 50 quota units, so this keeps a playlist to about a quarter of the
+// daily quota.
+const MaxPlaylistVideos = 50
+
+// ExportM3U writes the videos to an extended M3U playlist of YouTube URLs,
+// which players such as mpv and VLC can open
+func (c *Client) ExportM3U(path string, videos []Video) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating playlist file: %w", err)
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	fmt.Fprintln(w, "#EXTM3U")
+	for _, video := range videos {
+		// Line breaks would end the entry early
+		title := strings.Join(strings.Fields(video.ChannelName+" - "+video.Title), " ")
+		fmt.Fprintf(w, "#EXTINF:-1,%s\n", title)
+		fmt.Fprintln(w, c.ShareURL(video.ID))
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("error writing playlist file: %w", err)
+	}
+	return file.Close()
+}
+
+// CreatePlaylistFromVideos creates an unlisted playlist on the user's YouTube
+// account with the videos, up to MaxPlaylistVideos of them, and returns its
+// URL. If adding a video fails, the URL of the playlist with the videos added
+// so far is returned along with the error. It needs OAuth credentials, see
+// SetOAuthCredentials.
+func (c *Client) CreatePlaylistFromVideos(title string, videos []Video) (string, error) {
+	if err := c.checkQuota(); err != nil {
+		return "", err
+	}
+	if len(videos) > MaxPlaylistVideos {
+		videos = videos[:MaxPlaylistVideos]
+	}
+
+	ctx := context.Background()
+	service, err := c.accountService(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	insert := func(service *youtube.Service) (*youtube.Playlist, error) {
+		c.useQuota(quotaCostInsert)
+		return service.Playlists.Insert([]string{"snippet", "status"}, &youtube.Playlist{
+			Snippet: &youtube.PlaylistSnippet{
+				Title:       title,
+				Description: "Created with ytviewer",
+			},
+			Status: &youtube.PlaylistStatus{PrivacyStatus: "unlisted"},
+		}).Context(ctx).Do()
+	}
+	playlist, err := insert(service)
+	if isUnauthorized(err) {
+		// Access was revoked since the token was last renewed, grant it again
+		if service, err = c.reauthorize(ctx); err != nil {
+			return "", err
+		}
+		playlist, err = insert(service)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error creating playlist: %w", apiError(err))
+	}
+
+	url := "https://www.youtube.com/playlist?list=" + playlist.Id
+	for _, video := range videos {
+		c.useQuota(quotaCostInsert)
+		_, err := service.PlaylistItems.Insert([]string{"snippet"}, &youtube.PlaylistItem{
+			Snippet: &youtube.PlaylistItemSnippet{
+				PlaylistId: playlist.Id,
+				ResourceId: &youtube.ResourceId{Kind: "youtube#video", VideoId: video.ID},
+			},
+		}).Context(ctx).Do()
+		if err != nil {
+			return url, fmt.Errorf("error adding %q to the playlist: %w", video.Title, apiError(err))
+		}
+	}
+
+	return url, nil
+}
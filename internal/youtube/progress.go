@@ -0,0 +1,131 @@
+package youtube
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ChannelProgress is the newest video watched in a channel, where catching
+// up on its backlog resumes from
+type ChannelProgress struct {
+	VideoID     string    `json:"video_id"`
+	Title       string    `json:"title"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// getChannelProgressPath returns the path to the channel progress file
+func (c *Client) getChannelProgressPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "channel_progress.json"), nil
+}
+
+// GetChannelProgress returns the newest watched video of each channel by channel ID
+func (c *Client) GetChannelProgress() (map[string]ChannelProgress, error) {
+	progress := make(map[string]ChannelProgress)
+
+	progressPath, err := c.getChannelProgressPath()
+	if err != nil {
+		return progress, err
+	}
+
+	data, err := os.ReadFile(progressPath)
+	if os.IsNotExist(err) {
+		return progress, nil
+	}
+	if err != nil {
+		return progress, err
+	}
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return make(map[string]ChannelProgress), fmt.Errorf("error parsing channel progress: %w", err)
+	}
+	return progress, nil
+}
+
+// saveChannelProgress writes the channel progress store
+func (c *Client) saveChannelProgress(progress map[string]ChannelProgress) error {
+	progressPath, err := c.getChannelProgressPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(progressPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(progressPath, data, 0644)
+}
+
+// updateChannelProgress moves each affected channel's progress after the
+// videos were marked or unmarked as watched. Videos are looked up in the
+// video cache, ones that aren't cached don't affect the progress.
+func (c *Client) updateChannelProgress(videoIDs []string, watched bool, history map[string]time.Time) error {
+	cached := c.cachedVideos()
+	videos := make(map[string]Video)
+	for _, channelVideos := range cached {
+		for _, video := range channelVideos {
+			videos[video.ID] = video
+		}
+	}
+
+	progress, err := c.GetChannelProgress()
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, id := range videoIDs {
+		video, ok := videos[id]
+		if !ok {
+			continue
+		}
+		current, hasProgress := progress[video.ChannelID]
+
+		if watched {
+			if !hasProgress || video.PublishedAt.After(current.PublishedAt) {
+				progress[video.ChannelID] = progressOf(video)
+				changed = true
+			}
+			continue
+		}
+
+		// Unwatching the video the channel resumed from falls back to the
+		// newest cached video of the channel that is still watched
+		if hasProgress && current.VideoID == id {
+			delete(progress, video.ChannelID)
+			for _, other := range cached[video.ChannelID] {
+				if _, ok := history[other.ID]; !ok {
+					continue
+				}
+				if latest, ok := progress[video.ChannelID]; !ok || other.PublishedAt.After(latest.PublishedAt) {
+					progress[video.ChannelID] = progressOf(other)
+				}
+			}
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	return c.saveChannelProgress(progress)
+}
+
+// progressOf returns the channel progress pointing at the video
+func progressOf(video Video) ChannelProgress {
+	return ChannelProgress{
+		VideoID:     video.ID,
+		Title:       video.Title,
+		PublishedAt: video.PublishedAt,
+	}
+}
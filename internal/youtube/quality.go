@@ -0,0 +1,113 @@
+package youtube
+
+import (
+	"fmt"
+
+	"github.com/fabean/ytviewer/internal/config"
+)
+
+// StreamQuality selects what MPV streams
+type StreamQuality string
+
+const (
+	// QualityDefault streams video up to mpv_options.max_resolution
+	QualityDefault StreamQuality = ""
+
+	// QualityLow streams 360p video, to save data
+	QualityLow StreamQuality = "360p"
+
+	// QualityAudioOnly streams only the audio track
+	QualityAudioOnly StreamQuality = "audio"
+
+	// QualityBest streams the best video available, without a resolution cap
+	QualityBest StreamQuality = "best"
+)
+
+// DefaultMaxHeight is the highest resolution streamed by default when
+// mpv_options.max_resolution isn't set to a valid height
+const DefaultMaxHeight = 1080
+
+// Label describes the quality for prompts and notifications
+func (q StreamQuality) Label() string {
+	switch q {
+	case QualityLow:
+		return "360p"
+	case QualityAudioOnly:
+		return "audio only"
+	case QualityBest:
+		return "best"
+	default:
+		return fmt.Sprintf("up to %dp", DefaultMaxHeight)
+	}
+}
+
+// SetPlayProfile sets the streaming settings of the active play profile
+func (c *Client) SetPlayProfile(profile config.PlayProfile) {
+	c.playProfile = profile
+}
+
+// SetChannelResolutions sets the resolution each channel's videos are
+// streamed at, as channel_resolution values
+func (c *Client) SetChannelResolutions(resolutions map[string]string) {
+	c.channelResolutions = make(map[string]string, len(resolutions))
+	for channelID, resolution := range resolutions {
+		c.channelResolutions[channelID] = resolution
+	}
+}
+
+// streamMPVArgs returns the MPV arguments for streaming a channel's video at
+// the given quality. Unless a quality was explicitly chosen, the channel's
+// resolution and the active play profile apply, the lower of the two winning
+// so a profile for a slow connection still caps every channel. Without
+// either, mpv_options.max_resolution caps the stream.
+func (c *Client) streamMPVArgs(channelID string, quality StreamQuality) []string {
+	quality, maxHeight := c.streamQuality(channelID, quality)
+	args := qualityMPVArgs(quality, maxHeight)
+	return append(args, c.optionsMPVArgs()...)
+}
+
+// streamQuality settles the quality a channel's video streams at, and the
+// highest resolution for the default quality
+func (c *Client) streamQuality(channelID string, quality StreamQuality) (StreamQuality, int) {
+	profile := c.playProfile
+	maxHeight := profile.MaxResolution
+	if quality == QualityDefault {
+		// Values were validated when loading the config
+		if resolution, ok := c.channelResolutions[channelID]; ok {
+			audioOnly, channelHeight, _ := config.ParseChannelResolution(resolution)
+			switch {
+			case audioOnly:
+				quality = QualityAudioOnly
+			case channelHeight > 0 && (maxHeight == 0 || channelHeight < maxHeight):
+				maxHeight = channelHeight
+			case channelHeight == 0 && maxHeight == 0:
+				quality = QualityBest
+			}
+		}
+		if profile.AudioOnly {
+			quality = QualityAudioOnly
+		}
+	}
+	if maxHeight == 0 {
+		maxHeight = c.maxHeight
+	}
+	return quality, maxHeight
+}
+
+// qualityMPVArgs returns the MPV arguments selecting the stream format. The
+// default quality streams up to maxHeight, or DefaultMaxHeight when it is 0.
+func qualityMPVArgs(quality StreamQuality, maxHeight int) []string {
+	switch quality {
+	case QualityLow:
+		return []string{"--ytdl-format=bestvideo[height<=360]+bestaudio/best[height<=360]"}
+	case QualityAudioOnly:
+		return []string{"--ytdl-format=bestaudio/best", "--no-video"}
+	case QualityBest:
+		return []string{"--ytdl-format=bestvideo+bestaudio/best"}
+	default:
+		if maxHeight <= 0 {
+			maxHeight = DefaultMaxHeight
+		}
+		return []string{fmt.Sprintf("--ytdl-format=bestvideo[height<=%[1]d]+bestaudio/best[height<=%[1]d]", maxHeight)}
+	}
+}
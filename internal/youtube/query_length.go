@@ -0,0 +1,96 @@
+package youtube
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxQueryValueLength is the longest any single query parameter may get once
+// URL-encoded. Google's front end rejects request URLs over about 8 KB with a
+// 414, this leaves plenty of room for the other parameters and the API key.
+const maxQueryValueLength = 2000
+
+// queryLength returns the length of the value once URL-encoded
+func queryLength(value string) int {
+	return len(url.QueryEscape(value))
+}
+
+// idBatches splits ids into batches for list calls: at most 50 IDs each, the
+// API's limit, and short enough once comma-joined and encoded to stay within
+// maxQueryValueLength. IDs too long to fit even on their own can't exist, so
+// they're left out and come back as missing like any other unknown ID.
+func idBatches(ids []string) [][]string {
+	var batches [][]string
+	var batch []string
+	length := 0
+	for _, id := range ids {
+		idLength := queryLength(id)
+		if idLength > maxQueryValueLength {
+			continue
+		}
+
+		// Every ID after the first also adds an encoded comma
+		added := idLength
+		if len(batch) > 0 {
+			added += queryLength(",")
+		}
+		if len(batch) == maxIDsPerRequest || length+added > maxQueryValueLength {
+			batches = append(batches, batch)
+			batch, length, added = nil, 0, idLength
+		}
+		batch = append(batch, id)
+		length += added
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// checkQueryValue returns an error for a single value, such as a channel ID
+// or handle typed in, too long to send in a request
+func checkQueryValue(name, value string) error {
+	if queryLength(value) > maxQueryValueLength {
+		return fmt.Errorf("%s is too long to look up (%d characters)", name, utf8.RuneCountInString(value))
+	}
+	return nil
+}
+
+// fitQuery joins the search terms with sep, leaving out the terms that would
+// take the query past maxQueryValueLength. A first term too long on its own
+// is cut short instead.
+func fitQuery(terms []string, sep string) string {
+	var query string
+	for i, term := range terms {
+		candidate := term
+		if i > 0 {
+			candidate = query + sep + term
+		}
+		if queryLength(candidate) > maxQueryValueLength {
+			if i == 0 {
+				return cutQuery(term)
+			}
+			break
+		}
+		query = candidate
+	}
+	return query
+}
+
+// cutQuery shortens a single search term to fit maxQueryValueLength, without
+// splitting a character
+func cutQuery(term string) string {
+	var sb strings.Builder
+	length := 0
+	for _, r := range term {
+		runeLength := queryLength(string(r))
+		if length+runeLength > maxQueryValueLength {
+			break
+		}
+		sb.WriteRune(r)
+		length += runeLength
+	}
+	return strings.TrimSpace(sb.String())
+}
@@ -0,0 +1,90 @@
+package youtube
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func batchSizes(batches [][]string) []int {
+	sizes := []int{}
+	for _, batch := range batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+func TestIDBatches(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []string
+		want []int
+	}{
+		{"no IDs", nil, []int{}},
+		{"one ID", channelIDs(1), []int{1}},
+		{"50 IDs", channelIDs(50), []int{50}},
+		{"51 IDs", channelIDs(51), []int{50, 1}},
+		{"120 IDs", channelIDs(120), []int{50, 50, 20}},
+		// 1000 + "%2C" + 997 comes to exactly maxQueryValueLength
+		{"exactly at the limit", []string{strings.Repeat("a", 1000), strings.Repeat("b", 997)}, []int{2}},
+		{"one over the limit", []string{strings.Repeat("a", 1000), strings.Repeat("b", 998)}, []int{1, 1}},
+		{"single ID at the limit", []string{strings.Repeat("a", maxQueryValueLength)}, []int{1}},
+		{"single ID over the limit", []string{strings.Repeat("a", maxQueryValueLength+1)}, []int{}},
+		// 700 characters, but each encodes to three
+		{"ID over the limit once encoded", []string{strings.Repeat("%", 700)}, []int{}},
+		{"huge ID among others", append(channelIDs(2), strings.Repeat("a", 10000), "UCafter"), []int{3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batches := idBatches(tt.ids)
+			if got := batchSizes(batches); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("batch sizes = %v, want %v", got, tt.want)
+			}
+			for i, batch := range batches {
+				if length := queryLength(strings.Join(batch, ",")); length > maxQueryValueLength {
+					t.Errorf("batch %d encodes to %d characters, over %d", i, length, maxQueryValueLength)
+				}
+			}
+		})
+	}
+}
+
+func TestIDBatchesKeepsOrder(t *testing.T) {
+	huge := strings.Repeat("x", maxQueryValueLength+1)
+	ids := []string{"UCa", huge, "UCb", "UCc"}
+	var got []string
+	for _, batch := range idBatches(ids) {
+		got = append(got, batch...)
+	}
+	if want := []string{"UCa", "UCb", "UCc"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("idBatches kept %v, want %v", got, want)
+	}
+}
+
+func TestFitQuery(t *testing.T) {
+	short := []string{"go", "rust", "zig"}
+	if got := fitQuery(short, " | "); got != "go | rust | zig" {
+		t.Errorf("fitQuery(%v) = %q", short, got)
+	}
+
+	long := []string{strings.Repeat("a", 1500), strings.Repeat("b", 600)}
+	if got := fitQuery(long, "|"); got != long[0] {
+		t.Errorf("fitQuery left in a term past the limit, got %d characters", len(got))
+	}
+
+	if got := fitQuery([]string{strings.Repeat("a", 3000)}, "|"); queryLength(got) != maxQueryValueLength {
+		t.Errorf("fitQuery cut the first term to %d characters, want %d", queryLength(got), maxQueryValueLength)
+	}
+}
+
+func TestCutQuery(t *testing.T) {
+	// "é" encodes to "%C3%A9", six characters, so it can't be split in half
+	term := strings.Repeat("é", 400)
+	got := cutQuery(term)
+	if length := queryLength(got); length > maxQueryValueLength {
+		t.Fatalf("cutQuery left %d characters, over %d", length, maxQueryValueLength)
+	}
+	if want := strings.Repeat("é", maxQueryValueLength/6); got != want {
+		t.Errorf("cutQuery kept %d runes, want %d", len([]rune(got)), maxQueryValueLength/6)
+	}
+}
@@ -0,0 +1,183 @@
+package youtube
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// quotaCostList is the quota cost of a list call such as channels.list,
+	// playlistItems.list or videos.list
+	quotaCostList = 1
+
+	// quotaCostSearch is the quota cost of a search.list call
+	quotaCostSearch = 100
+)
+
+// quotaUsage is the estimated quota used on a single quota day
+type quotaUsage struct {
+	Day   string `json:"day"` // Pacific date the quota day started, YYYY-MM-DD
+	Units int    `json:"units"`
+}
+
+// QuotaExceededError is returned when the API key's daily quota is used up
+type QuotaExceededError struct {
+	ResetAt time.Time // When the quota is expected to reset
+}
+
+// Error implements the error interface
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("the YouTube API daily quota is exhausted, it resets around %s",
+		e.ResetAt.Local().Format("Jan 2 15:04"))
+}
+
+// NextQuotaReset returns when the daily quota next resets. Google resets
+// quotas at midnight Pacific Time.
+func NextQuotaReset(now time.Time) time.Time {
+	local := now.In(pacificLocation())
+	return time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, local.Location())
+}
+
+// pacificLocation returns the time zone quotas reset in. It's loaded once,
+// useQuota reads it on every API call.
+var pacificLocation = sync.OnceValue(func() *time.Location {
+	pacific, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		// No time zone database, assume standard time
+		pacific = time.FixedZone("PST", -8*60*60)
+	}
+	return pacific
+})
+
+// quotaDay returns the quota day now falls in
+func quotaDay(now time.Time) string {
+	return now.In(pacificLocation()).Format("2006-01-02")
+}
+
+// getQuotaUsagePath returns the path to the quota usage file
+func (c *Client) getQuotaUsagePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "quota_usage.json"), nil
+}
+
+// loadQuotaUsage restores today's quota usage from disk, if present
+func (c *Client) loadQuotaUsage() error {
+	usagePath, err := c.getQuotaUsagePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(usagePath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var usage quotaUsage
+	if err := json.Unmarshal(data, &usage); err != nil {
+		return fmt.Errorf("error parsing quota usage: %w", err)
+	}
+
+	c.quotaMu.Lock()
+	c.quotaUsage = usage
+	c.quotaMu.Unlock()
+	return nil
+}
+
+// useQuota adds the cost of an API call to today's estimated usage. It is
+// called from the fetch workers, so the usage is guarded by quotaMu. The
+// usage is only kept in memory here, SaveQuotaUsage writes it out.
+func (c *Client) useQuota(units int) {
+	c.quotaMu.Lock()
+	defer c.quotaMu.Unlock()
+
+	if today := quotaDay(c.now()); c.quotaUsage.Day != today {
+		c.quotaUsage = quotaUsage{Day: today}
+	}
+	c.quotaUsage.Units += units
+	c.quotaDirty = true
+}
+
+// SaveQuotaUsage writes the quota used today to disk if it changed since the
+// last save. It runs after each fetch and on exit, rather than on every call.
+func (c *Client) SaveQuotaUsage() error {
+	c.quotaMu.Lock()
+	defer c.quotaMu.Unlock()
+
+	if !c.quotaDirty {
+		return nil
+	}
+
+	usagePath, err := c.getQuotaUsagePath()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(c.quotaUsage)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(usagePath, data, 0644); err != nil {
+		return err
+	}
+	c.quotaDirty = false
+	return nil
+}
+
+// QuotaUsedToday returns the estimated quota units used since the last reset,
+// counting the calls made by ytviewer
+func (c *Client) QuotaUsedToday() int {
+	c.quotaMu.Lock()
+	defer c.quotaMu.Unlock()
+
+	if c.quotaUsage.Day != quotaDay(c.now()) {
+		return 0
+	}
+	return c.quotaUsage.Units
+}
+
+// QuotaExhaustedUntil returns when the exhausted quota resets, or a zero
+// time if the quota isn't known to be exhausted
+func (c *Client) QuotaExhaustedUntil() time.Time {
+	if c.now().Before(c.quotaResetAt) {
+		return c.quotaResetAt
+	}
+	return time.Time{}
+}
+
+// SetQuotaResetAt restores a quota reset time saved by a previous run
+func (c *Client) SetQuotaResetAt(resetAt time.Time) {
+	c.quotaResetAt = resetAt
+}
+
+// checkQuota returns a QuotaExceededError while the quota is exhausted so
+// live fetches can be skipped instead of failing against the API
+func (c *Client) checkQuota() error {
+	if resetAt := c.QuotaExhaustedUntil(); !resetAt.IsZero() {
+		return &QuotaExceededError{ResetAt: resetAt}
+	}
+	return nil
+}
+
+// recordQuotaExceeded remembers the reset time of a quota error, in memory
+// and in the config so it survives restarts, and reports whether err was one
+func (c *Client) recordQuotaExceeded(err error) bool {
+	var quotaErr *QuotaExceededError
+	if !errors.As(err, &quotaErr) {
+		return false
+	}
+
+	c.quotaResetAt = quotaErr.ResetAt
+	// Best effort, at worst the next run tries the API once more
+	_ = c.updateConfig("quota_reset_at", quotaErr.ResetAt)
+	return true
+}
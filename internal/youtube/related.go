@@ -0,0 +1,87 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxRelatedVideos is how many related videos are fetched per video
+const maxRelatedVideos = 25
+
+// GetRelatedVideos finds videos related to the given video. The search.list
+// relatedToVideoId parameter has been removed from the API, so this searches
+// for the video's tags (or its title when it has none) instead. Each lookup
+// costs about 101 quota units (videos.list + search.list), so results are
+// cached per video for the rest of the session.
+func (c *Client) GetRelatedVideos(videoID string) ([]Video, error) {
+	if videos, ok := c.relatedCache[videoID]; ok {
+		return videos, nil
+	}
+
+	// Skip the lookup while the quota is exhausted
+	if err := c.checkQuota(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Look up the video's title and tags to build the search query
+	c.useQuota(quotaCostList)
+	videoResponse, err := c.service.Videos.List([]string{"snippet"}).
+		Id(videoID).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching video: %w", apiError(err))
+	}
+	if len(videoResponse.Items) == 0 {
+		return nil, fmt.Errorf("video not found")
+	}
+
+	snippet := videoResponse.Items[0].Snippet
+	query := fitQuery([]string{snippet.Title}, "")
+	if len(snippet.Tags) > 0 {
+		tags := snippet.Tags
+		if len(tags) > 5 {
+			tags = tags[:5]
+		}
+		query = fitQuery(tags, " | ")
+	}
+
+	c.useQuota(quotaCostSearch)
+	searchResponse, err := c.service.Search.List([]string{"snippet"}).
+		Q(query).
+		Type("video").
+		MaxResults(maxRelatedVideos + 1).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("error searching related videos: %w", apiError(err))
+	}
+
+	videos := make([]Video, 0, len(searchResponse.Items))
+	for _, item := range searchResponse.Items {
+		if item.Id == nil || item.Id.VideoId == "" || item.Id.VideoId == videoID {
+			continue
+		}
+
+		publishedAt, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
+		if err != nil {
+			publishedAt = c.now()
+		}
+
+		videos = append(videos, Video{
+			ID:          item.Id.VideoId,
+			Title:       item.Snippet.Title,
+			ChannelID:   item.Snippet.ChannelId,
+			ChannelName: item.Snippet.ChannelTitle,
+			PublishedAt: publishedAt,
+			Thumbnail:   c.thumbnailURL(item.Snippet.Thumbnails),
+		})
+	}
+
+	c.relatedCache[videoID] = videos
+	return videos, nil
+}
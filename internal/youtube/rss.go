@@ -0,0 +1,170 @@
+package youtube
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// rssFeedURL is the public uploads feed of a channel, which costs no API quota
+const rssFeedURL = "https://www.youtube.com/feeds/videos.xml?channel_id="
+
+// rssConcurrency is how many channel feeds are checked at once
+const rssConcurrency = 8
+
+// rssFeed is the part of a channel's Atom feed a soft refresh needs
+type rssFeed struct {
+	Entries []struct {
+		VideoID string `xml:"videoId"`
+	} `xml:"entry"`
+}
+
+// latestUploadID returns the ID of the channel's newest upload from its RSS
+// feed, empty if the channel has no uploads
+func latestUploadID(ctx context.Context, client *http.Client, channelID string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rssFeedURL+channelID, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("channel feed returned %s", resp.Status)
+	}
+
+	var feed rssFeed
+	if err := xml.NewDecoder(resp.Body).Decode(&feed); err != nil {
+		return "", fmt.Errorf("error parsing channel feed: %w", err)
+	}
+	if len(feed.Entries) == 0 {
+		return "", nil
+	}
+	return feed.Entries[0].VideoID, nil
+}
+
+// changedChannels checks the RSS feed of every subscribed channel and returns
+// the channels whose newest upload isn't in the cache yet. Channels whose feed
+// can't be read are included so the API decides. The bool reports whether
+// every feed failed with a network error.
+func (c *Client) changedChannels() ([]string, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	changed := make([]bool, len(c.subscribedChannels))
+	networkErrors := make([]bool, len(c.subscribedChannels))
+	slots := make(chan struct{}, rssConcurrency)
+	var wg sync.WaitGroup
+	cachedVideos := c.cachedVideos()
+	for i, channelID := range c.subscribedChannels {
+		cached, ok := cachedVideos[channelID]
+		if _, standard := uploadsPlaylistID(channelID); !ok || !standard {
+			// Nothing to compare against, or no feed for this kind of ID
+			changed[i] = true
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, channelID string, cached []Video) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			latestID, err := latestUploadID(ctx, client, channelID)
+			if err != nil {
+				changed[i] = true
+				networkErrors[i] = isNetworkError(err)
+				return
+			}
+			if latestID == "" {
+				return
+			}
+			for _, video := range cached {
+				if video.ID == latestID {
+					return
+				}
+			}
+			changed[i] = true
+		}(i, channelID, cached)
+	}
+	wg.Wait()
+
+	var channelIDs []string
+	offline := len(c.subscribedChannels) > 0
+	for i, channelID := range c.subscribedChannels {
+		if changed[i] {
+			channelIDs = append(channelIDs, channelID)
+		}
+		if !networkErrors[i] {
+			offline = false
+		}
+	}
+	return channelIDs, offline
+}
+
+// SoftRefresh checks each channel's RSS feed, which costs no API quota, and
+// only fetches the channels with new uploads through the API. Channels
+// without cached videos are always fetched. Refetched reports how many
+// channels went to the API.
+func (c *Client) SoftRefresh() (FetchResult, error) {
+	channelIDs, offline := c.changedChannels()
+	if offline {
+		return c.offlineResult()
+	}
+	if len(channelIDs) > 0 {
+		if err := c.checkQuota(); err != nil {
+			return c.quotaExhaustedResult(), nil
+		}
+	}
+
+	c.videoCacheMu.RLock()
+	fetchErrors := make(map[string]ChannelError)
+	for _, failed := range c.fetchErrors {
+		fetchErrors[failed.ChannelID] = failed
+	}
+	c.videoCacheMu.RUnlock()
+	for _, batch := range chunk(channelIDs, maxIDsPerRequest) {
+		for _, channelID := range batch {
+			delete(fetchErrors, channelID)
+		}
+		batchResult, err := c.fetchVideosForChannels(batch)
+		if c.recordQuotaExceeded(err) {
+			return c.quotaExhaustedResult(), nil
+		}
+		if isNetworkError(err) {
+			return c.offlineResult()
+		}
+		if err != nil {
+			return FetchResult{}, err
+		}
+		for _, failed := range batchResult.Errors {
+			fetchErrors[failed.ChannelID] = failed
+		}
+	}
+
+	failedChannels := make([]ChannelError, 0, len(fetchErrors))
+	for _, failed := range fetchErrors {
+		failedChannels = append(failedChannels, failed)
+	}
+	sort.Slice(failedChannels, func(i, j int) bool {
+		return failedChannels[i].ChannelName < failedChannels[j].ChannelName
+	})
+	c.videoCacheMu.Lock()
+	c.fetchErrors = failedChannels
+	c.lastFetchTime = c.now()
+	c.videoCacheMu.Unlock()
+
+	// Persist the cache for the next run, failing to do so isn't fatal
+	_ = c.saveVideoCache()
+
+	result := c.GetLatestVideosCachedOnly()
+	result.Refetched = len(channelIDs)
+	return result, nil
+}
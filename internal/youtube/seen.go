@@ -0,0 +1,125 @@
+package youtube
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// seenRetention is how long videos that have left the feed are remembered
+const seenRetention = 30 * 24 * time.Hour
+
+// SeenVideo records when a video first appeared in the feed
+type SeenVideo struct {
+	FirstSeen time.Time `json:"first_seen"`
+	Cleared   bool      `json:"cleared,omitempty"` // The user has interacted with it, so it's no longer new
+}
+
+// IsNew reports whether the video is still new: first seen within the
+// window and not interacted with since
+func (s SeenVideo) IsNew(window time.Duration, now time.Time) bool {
+	return !s.Cleared && now.Sub(s.FirstSeen) < window
+}
+
+// getSeenVideosPath returns the path to the seen videos file
+func (c *Client) getSeenVideosPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "seen.json"), nil
+}
+
+// loadSeenVideos reads the seen videos store. It reports whether the store
+// existed, so the first run doesn't flag the whole feed as new.
+func (c *Client) loadSeenVideos() (map[string]SeenVideo, bool, error) {
+	seen := make(map[string]SeenVideo)
+
+	seenPath, err := c.getSeenVideosPath()
+	if err != nil {
+		return seen, false, err
+	}
+
+	data, err := os.ReadFile(seenPath)
+	if os.IsNotExist(err) {
+		return seen, false, nil
+	}
+	if err != nil {
+		return seen, false, err
+	}
+	if err := json.Unmarshal(data, &seen); err != nil {
+		return make(map[string]SeenVideo), false, fmt.Errorf("error parsing seen videos: %w", err)
+	}
+	return seen, true, nil
+}
+
+// saveSeenVideos writes the seen videos store
+func (c *Client) saveSeenVideos(seen map[string]SeenVideo) error {
+	seenPath, err := c.getSeenVideosPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(seenPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(seen)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(seenPath, data, 0644)
+}
+
+// RecordSeen records the first time each of the feed's videos was shown and
+// returns the seen state of every video in the store. Videos that have been
+// out of the feed for a while are forgotten to keep the store small.
+func (c *Client) RecordSeen(videos []Video) (map[string]SeenVideo, error) {
+	c.seenMu.Lock()
+	defer c.seenMu.Unlock()
+
+	seen, existed, err := c.loadSeenVideos()
+	if err != nil {
+		return seen, err
+	}
+
+	now := c.now()
+	inFeed := make(map[string]bool, len(videos))
+	for _, video := range videos {
+		inFeed[video.ID] = true
+		if _, ok := seen[video.ID]; !ok {
+			// On the very first run nothing is new, it's all just the feed
+			seen[video.ID] = SeenVideo{FirstSeen: now, Cleared: !existed}
+		}
+	}
+
+	for id, entry := range seen {
+		if !inFeed[id] && now.Sub(entry.FirstSeen) > seenRetention {
+			delete(seen, id)
+		}
+	}
+
+	return seen, c.saveSeenVideos(seen)
+}
+
+// ClearNew marks a video as no longer new after the user interacts with it
+func (c *Client) ClearNew(videoID string) error {
+	c.seenMu.Lock()
+	defer c.seenMu.Unlock()
+
+	seen, _, err := c.loadSeenVideos()
+	if err != nil {
+		return err
+	}
+
+	entry, ok := seen[videoID]
+	if !ok || entry.Cleared {
+		return nil
+	}
+	entry.Cleared = true
+	seen[videoID] = entry
+	return c.saveSeenVideos(seen)
+}
@@ -0,0 +1,45 @@
+package youtube
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxShortDuration is the longest video counted as a Short by its length
+// alone. Shorts can run up to 3 minutes, but so do plenty of regular videos.
+const maxShortDuration = 60 * time.Second
+
+// isoDurationPattern matches the ISO 8601 durations the API returns, e.g. "PT1M30S"
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// looksLikeShort is the first pass at spotting a Short from what the
+// playlist and search results already include, a #shorts marker in the title
+// or description. Their thumbnails are always the landscape default, mqdefault
+// and hqdefault crops, so they don't tell Shorts apart. Videos it misses are
+// settled by their duration later.
+func looksLikeShort(title, description string) bool {
+	return strings.Contains(strings.ToLower(title+" "+description), "#shorts")
+}
+
+// parseISODuration parses an ISO 8601 duration such as "PT1M30S"
+func parseISODuration(value string) (time.Duration, bool) {
+	match := isoDurationPattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, false
+	}
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+	for i, unit := range units {
+		if match[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return 0, false
+		}
+		duration += time.Duration(n) * unit
+	}
+	return duration, true
+}
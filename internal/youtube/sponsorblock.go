@@ -0,0 +1,66 @@
+package youtube
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sponsorBlockCategories are the SponsorBlock segments skipped when enabled
+var sponsorBlockCategories = []string{"sponsor", "intro", "outro", "selfpromo"}
+
+// SetSponsorBlock sets whether sponsor, intro and outro segments are skipped
+func (c *Client) SetSponsorBlock(enabled bool) {
+	c.sponsorBlock = enabled
+}
+
+// SponsorBlockWarning returns a warning when SponsorBlock is enabled but the
+// mpv script that skips segments while streaming isn't installed
+func (c *Client) SponsorBlockWarning() string {
+	if !c.sponsorBlock || sponsorBlockScriptInstalled() {
+		return ""
+	}
+	return "SponsorBlock is enabled but the mpv_sponsorblock script isn't installed in your mpv scripts directory, segments won't be skipped while streaming"
+}
+
+// sponsorBlockScriptInstalled reports whether the mpv_sponsorblock script is
+// in mpv's scripts directory, where mpv loads it automatically
+func sponsorBlockScriptInstalled() bool {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return false
+		}
+		configDir = filepath.Join(homeDir, ".config")
+	}
+
+	entries, err := os.ReadDir(filepath.Join(configDir, "mpv", "scripts"))
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(strings.ToLower(entry.Name()), "sponsorblock") {
+			return true
+		}
+	}
+	return false
+}
+
+// sponsorBlockMPVArgs returns the mpv arguments that tell the mpv_sponsorblock
+// script which segments to skip
+func (c *Client) sponsorBlockMPVArgs() []string {
+	if !c.sponsorBlock || !sponsorBlockScriptInstalled() {
+		return nil
+	}
+	return []string{"--script-opts=sponsorblock-skip_categories=" + strings.Join(sponsorBlockCategories, ",")}
+}
+
+// sponsorBlockDownloadArgs returns the yt-dlp arguments that cut the
+// segments out of downloaded videos
+func (c *Client) sponsorBlockDownloadArgs() []string {
+	if !c.sponsorBlock {
+		return nil
+	}
+	return []string{"--sponsorblock-remove", strings.Join(sponsorBlockCategories, ",")}
+}
@@ -0,0 +1,119 @@
+package youtube
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// starredVideo is a favorite video as stored on disk. The whole video is
+// kept so favorites can be shown long after they leave the feed.
+type starredVideo struct {
+	Video     Video     `json:"video"`
+	StarredAt time.Time `json:"starred_at"`
+}
+
+// getStarredPath returns the path to the favorites file
+func (c *Client) getStarredPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "starred.json"), nil
+}
+
+// loadStarred reads the favorites, keyed by video ID
+func (c *Client) loadStarred() (map[string]starredVideo, error) {
+	starred := make(map[string]starredVideo)
+
+	starredPath, err := c.getStarredPath()
+	if err != nil {
+		return starred, err
+	}
+
+	data, err := os.ReadFile(starredPath)
+	if os.IsNotExist(err) {
+		return starred, nil
+	}
+	if err != nil {
+		return starred, err
+	}
+	if err := json.Unmarshal(data, &starred); err != nil {
+		return make(map[string]starredVideo), fmt.Errorf("error parsing favorites: %w", err)
+	}
+	return starred, nil
+}
+
+// saveStarred writes the favorites
+func (c *Client) saveStarred(starred map[string]starredVideo) error {
+	starredPath, err := c.getStarredPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(starredPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(starred)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(starredPath, data, 0644)
+}
+
+// StarVideo adds a video to the favorites
+func (c *Client) StarVideo(video Video) error {
+	starred, err := c.loadStarred()
+	if err != nil {
+		return err
+	}
+
+	// Keep the original star time if it's already a favorite
+	if _, ok := starred[video.ID]; ok {
+		return nil
+	}
+	starred[video.ID] = starredVideo{Video: video, StarredAt: c.now()}
+	return c.saveStarred(starred)
+}
+
+// UnstarVideo removes a video from the favorites
+func (c *Client) UnstarVideo(videoID string) error {
+	starred, err := c.loadStarred()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := starred[videoID]; !ok {
+		return nil
+	}
+	delete(starred, videoID)
+	return c.saveStarred(starred)
+}
+
+// GetStarred returns the favorite videos, most recently starred first
+func (c *Client) GetStarred() ([]Video, error) {
+	starred, err := c.loadStarred()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]starredVideo, 0, len(starred))
+	for _, entry := range starred {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].StarredAt.After(entries[j].StarredAt)
+	})
+
+	videos := make([]Video, len(entries))
+	for i, entry := range entries {
+		videos[i] = entry.Video
+	}
+	return videos, nil
+}
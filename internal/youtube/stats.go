@@ -0,0 +1,63 @@
+package youtube
+
+// Stats is an overview of the subscriptions, cache and watch history
+type Stats struct {
+	Subscriptions   int
+	CachedVideos    int
+	UnwatchedVideos int    // Cached videos that haven't been watched
+	WatchedThisWeek int    // Videos marked watched in the last 7 days
+	BusiestChannel  string // Channel with the most uploads in the last 7 days, empty if none
+	BusiestUploads  int
+	MostWatched     []ChannelWatches // Channels with the most videos watched this month, most first
+	QuotaUsedToday  int              // Estimated, counting only ytviewer's own calls
+	DataThisSession int64            // Estimated bytes streamed and downloaded since ytviewer started
+	DataToday       int64            // Estimated bytes streamed and downloaded today
+}
+
+// maxMostWatched is how many of the most-watched channels the stats list
+const maxMostWatched = 3
+
+// GetStats computes an overview from the client's caches and stores
+func (c *Client) GetStats() (Stats, error) {
+	history, err := c.GetWatchHistory()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	weekAgo := c.now().AddDate(0, 0, -7)
+	stats := Stats{
+		Subscriptions:  len(c.subscribedChannels),
+		QuotaUsedToday: c.QuotaUsedToday(),
+	}
+	stats.DataThisSession, stats.DataToday = c.DataUsage()
+
+	for _, watchedAt := range history {
+		if watchedAt.After(weekAgo) {
+			stats.WatchedThisWeek++
+		}
+	}
+
+	stats.MostWatched = c.watchesByChannel(history, startOfMonth(c.now()))
+	if len(stats.MostWatched) > maxMostWatched {
+		stats.MostWatched = stats.MostWatched[:maxMostWatched]
+	}
+
+	for _, videos := range c.cachedVideos() {
+		recent := 0
+		for _, video := range videos {
+			stats.CachedVideos++
+			if _, watched := history[video.ID]; !watched {
+				stats.UnwatchedVideos++
+			}
+			if video.PublishedAt.After(weekAgo) {
+				recent++
+			}
+		}
+		if recent > stats.BusiestUploads {
+			stats.BusiestUploads = recent
+			stats.BusiestChannel = videos[0].ChannelName
+		}
+	}
+
+	return stats, nil
+}
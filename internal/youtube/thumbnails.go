@@ -0,0 +1,72 @@
+package youtube
+
+import (
+	"google.golang.org/api/youtube/v3"
+)
+
+// thumbnailQualities are the thumbnail sizes the API offers, largest first
+var thumbnailQualities = []string{"maxres", "standard", "high", "medium", "default"}
+
+// DefaultThumbnailQuality is the thumbnail size stored when none is configured
+const DefaultThumbnailQuality = "medium"
+
+// SetThumbnailQuality sets which thumbnail size is stored on videos:
+// "default", "medium", "high", "standard" or "maxres"
+func (c *Client) SetThumbnailQuality(quality string) {
+	c.thumbnailQuality = quality
+}
+
+// thumbnailURL picks the configured thumbnail size, falling back to the next
+// smaller size the video has, then to the next larger one. Not every video
+// has every size, maxres in particular is often missing.
+func (c *Client) thumbnailURL(thumbnails *youtube.ThumbnailDetails) string {
+	if thumbnails == nil {
+		return ""
+	}
+
+	quality := c.thumbnailQuality
+	if quality == "" {
+		quality = DefaultThumbnailQuality
+	}
+	start := 0
+	for i, candidate := range thumbnailQualities {
+		if candidate == quality {
+			start = i
+			break
+		}
+	}
+
+	for i := start; i < len(thumbnailQualities); i++ {
+		if url := thumbnailOfQuality(thumbnails, thumbnailQualities[i]); url != "" {
+			return url
+		}
+	}
+	for i := start - 1; i >= 0; i-- {
+		if url := thumbnailOfQuality(thumbnails, thumbnailQualities[i]); url != "" {
+			return url
+		}
+	}
+	return ""
+}
+
+// thumbnailOfQuality returns the URL of one thumbnail size, empty if the
+// video doesn't have it
+func thumbnailOfQuality(thumbnails *youtube.ThumbnailDetails, quality string) string {
+	var thumbnail *youtube.Thumbnail
+	switch quality {
+	case "maxres":
+		thumbnail = thumbnails.Maxres
+	case "standard":
+		thumbnail = thumbnails.Standard
+	case "high":
+		thumbnail = thumbnails.High
+	case "medium":
+		thumbnail = thumbnails.Medium
+	case "default":
+		thumbnail = thumbnails.Default
+	}
+	if thumbnail == nil {
+		return ""
+	}
+	return thumbnail.Url
+}
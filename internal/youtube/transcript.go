@@ -0,0 +1,134 @@
+package youtube
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoTranscript is returned for videos without captions
+var ErrNoTranscript = errors.New("no captions available for this video")
+
+// transcriptLanguages are the caption languages yt-dlp is asked for, English
+// first, falling back to whatever the video was uploaded in
+const transcriptLanguages = "en.*,en,.*-orig"
+
+// TranscriptLine is one caption of a video's transcript
+type TranscriptLine struct {
+	Start time.Duration
+	Text  string
+}
+
+// FormatTimestamp formats the caption start like a chapter, e.g. 4:12 or 1:02:33
+func (l TranscriptLine) FormatTimestamp() string {
+	return Chapter{Start: l.Start}.FormatTimestamp()
+}
+
+// GetTranscript fetches the video's captions with yt-dlp, preferring
+// uploaded subtitles over automatic ones, and returns them as plain text
+// lines. It doesn't use any API quota.
+func (c *Client) GetTranscript(videoID string) ([]TranscriptLine, error) {
+	dir, err := os.MkdirTemp("", "ytviewer-transcript-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := exec.Command("yt-dlp",
+		"--skip-download",
+		"--write-subs",
+		"--write-auto-subs",
+		"--sub-langs", transcriptLanguages,
+		"--sub-format", "vtt",
+		"--output", filepath.Join(dir, "%(id)s"),
+		VideoURL(videoID),
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("yt-dlp is needed to fetch transcripts: %w", err)
+		}
+		// The last line of yt-dlp's output says what went wrong
+		lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+		return nil, fmt.Errorf("error fetching captions: %w: %s", err, lines[len(lines)-1])
+	}
+
+	// yt-dlp names the files <id>.<language>.vtt, uploaded subtitles are
+	// written alongside automatic ones when both exist
+	files, err := filepath.Glob(filepath.Join(dir, "*.vtt"))
+	if err != nil || len(files) == 0 {
+		return nil, ErrNoTranscript
+	}
+
+	file, err := os.Open(files[0])
+	if err != nil {
+		return nil, fmt.Errorf("error reading captions: %w", err)
+	}
+	defer file.Close()
+
+	lines, err := parseVTT(bufio.NewScanner(file))
+	if err != nil {
+		return nil, fmt.Errorf("error reading captions: %w", err)
+	}
+	if len(lines) == 0 {
+		return nil, ErrNoTranscript
+	}
+	return lines, nil
+}
+
+// vttTagPattern matches the inline timing and styling tags of WebVTT cues
+var vttTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// parseVTT reads the cues of a WebVTT file as transcript lines. Automatic
+// captions repeat the previous line at the start of each cue while the next
+// one is being spoken, so lines identical to the one before are dropped.
+func parseVTT(scanner *bufio.Scanner) ([]TranscriptLine, error) {
+	var (
+		lines    []TranscriptLine
+		start    time.Duration
+		inCue    bool
+		previous string
+	)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			inCue = false
+		case strings.Contains(line, "-->"):
+			start = parseVTTTimestamp(strings.Fields(line)[0])
+			inCue = true
+		case inCue:
+			text := strings.TrimSpace(html.UnescapeString(vttTagPattern.ReplaceAllString(line, "")))
+			if text == "" || text == previous {
+				continue
+			}
+			lines = append(lines, TranscriptLine{Start: start, Text: text})
+			previous = text
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// parseVTTTimestamp parses a cue timestamp such as 01:02:03.456 or 02:03.456,
+// ignoring the milliseconds. Malformed timestamps parse as 0.
+func parseVTTTimestamp(timestamp string) time.Duration {
+	timestamp, _, _ = strings.Cut(timestamp, ".")
+
+	var total time.Duration
+	for _, part := range strings.Split(timestamp, ":") {
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return 0
+		}
+		total = total*60 + time.Duration(value)
+	}
+	return total * time.Second
+}
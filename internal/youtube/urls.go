@@ -0,0 +1,87 @@
+package youtube
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// videoIDPattern matches a bare YouTube video ID
+var videoIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// VideoURL returns the full watch URL for a video
+func VideoURL(videoID string) string {
+	return "https://www.youtube.com/watch?v=" + videoID
+}
+
+// ShortVideoURL returns the short youtu.be URL for a video
+func ShortVideoURL(videoID string) string {
+	return "https://youtu.be/" + videoID
+}
+
+// URL returns the full watch URL for the video
+func (v Video) URL() string {
+	return VideoURL(v.ID)
+}
+
+// ShortURL returns the short youtu.be URL for the video
+func (v Video) ShortURL() string {
+	return ShortVideoURL(v.ID)
+}
+
+// ParseVideoID extracts the video ID from a YouTube URL or bare ID. It accepts
+// watch?v=, youtu.be/, /shorts/, /embed/ and /live/ URLs, with or without a scheme.
+func ParseVideoID(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if videoIDPattern.MatchString(input) {
+		return input, nil
+	}
+
+	raw := input
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("not a YouTube URL or video ID: %q", input)
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+	path := strings.Trim(u.Path, "/")
+
+	var id string
+	switch host {
+	case "youtu.be":
+		id = strings.SplitN(path, "/", 2)[0]
+	case "youtube.com", "music.youtube.com", "youtube-nocookie.com":
+		if path == "watch" {
+			id = u.Query().Get("v")
+		} else if parts := strings.SplitN(path, "/", 3); len(parts) >= 2 {
+			switch parts[0] {
+			case "shorts", "embed", "live", "v":
+				id = parts[1]
+			}
+		}
+	}
+
+	if !videoIDPattern.MatchString(id) {
+		return "", fmt.Errorf("not a YouTube URL or video ID: %q", input)
+	}
+	return id, nil
+}
+
+// ShareURL returns the URL used when copying, opening or picking a video, honoring
+// the short URL preference
+func (c *Client) ShareURL(videoID string) string {
+	if c.shortURLs {
+		return ShortVideoURL(videoID)
+	}
+	return VideoURL(videoID)
+}
+
+// SetShortURLs sets whether copied and opened URLs use the short youtu.be form
+func (c *Client) SetShortURLs(short bool) {
+	c.shortURLs = short
+}
@@ -0,0 +1,50 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GetVideo fetches a single video's details by ID
+func (c *Client) GetVideo(videoID string) (Video, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c.useQuota(quotaCostList)
+	response, err := c.service.Videos.List([]string{"snippet"}).
+		Id(videoID).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return Video{}, fmt.Errorf("error fetching video: %w", apiError(err))
+	}
+	if len(response.Items) == 0 {
+		return Video{}, fmt.Errorf("video not found")
+	}
+
+	snippet := response.Items[0].Snippet
+	publishedAt, err := time.Parse(time.RFC3339, snippet.PublishedAt)
+	if err != nil {
+		publishedAt = c.now()
+	}
+
+	return Video{
+		ID:          videoID,
+		Title:       snippet.Title,
+		ChannelID:   snippet.ChannelId,
+		ChannelName: snippet.ChannelTitle,
+		PublishedAt: publishedAt,
+		Thumbnail:   c.thumbnailURL(snippet.Thumbnails),
+	}, nil
+}
+
+// IsSubscribed reports whether the channel is in the subscription list
+func (c *Client) IsSubscribed(channelID string) bool {
+	for _, id := range c.subscribedChannels {
+		if id == channelID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package youtube
+
+import (
+	"sort"
+	"time"
+)
+
+// ChannelWatches is how many of a channel's videos were watched in a period
+type ChannelWatches struct {
+	ChannelID   string
+	ChannelName string
+	Watched     int
+}
+
+// rankingWindow is how far back watches count toward a channel's boost
+const rankingWindow = 30 * 24 * time.Hour
+
+// maxRankingBoost is how much newer the videos of the most-watched channel
+// are treated as when the feed is personalized
+const maxRankingBoost = 48 * time.Hour
+
+// watchesByChannel counts the videos watched since the given time per
+// channel. Watched videos are matched to channels through the video cache, so
+// videos that are no longer cached aren't counted.
+func (c *Client) watchesByChannel(history map[string]time.Time, since time.Time) []ChannelWatches {
+	counts := make(map[string]*ChannelWatches)
+	for _, videos := range c.cachedVideos() {
+		for _, video := range videos {
+			watchedAt, ok := history[video.ID]
+			if !ok || watchedAt.Before(since) {
+				continue
+			}
+			channel, ok := counts[video.ChannelID]
+			if !ok {
+				channel = &ChannelWatches{ChannelID: video.ChannelID, ChannelName: video.ChannelName}
+				counts[video.ChannelID] = channel
+			}
+			channel.Watched++
+		}
+	}
+
+	result := make([]ChannelWatches, 0, len(counts))
+	for _, channel := range counts {
+		result = append(result, *channel)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Watched != result[j].Watched {
+			return result[i].Watched > result[j].Watched
+		}
+		return result[i].ChannelName < result[j].ChannelName
+	})
+	return result
+}
+
+// startOfMonth returns midnight on the first day of t's month
+func startOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// ChannelBoosts returns how much newer each channel's videos should be
+// treated as when ranking the feed, in proportion to how many of its videos
+// were watched in the last 30 days. The most-watched channel gets the full
+// boost, channels nothing was watched from get none.
+func (c *Client) ChannelBoosts() (map[string]time.Duration, error) {
+	history, err := c.GetWatchHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	watches := c.watchesByChannel(history, c.now().Add(-rankingWindow))
+	boosts := make(map[string]time.Duration, len(watches))
+	if len(watches) == 0 {
+		return boosts, nil
+	}
+	most := watches[0].Watched
+	for _, channel := range watches {
+		boosts[channel.ChannelID] = maxRankingBoost * time.Duration(channel.Watched) / time.Duration(most)
+	}
+	return boosts, nil
+}
@@ -0,0 +1,373 @@
+package youtube
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// MarkVideoAsWatched marks a video as watched and saves to persistent storage
+func (c *Client) MarkVideoAsWatched(videoID string) error {
+	history, err := c.modifyWatchHistory(func(history map[string]time.Time) {
+		// Mark as watched, keeping the original time if it was already watched
+		if _, ok := history[videoID]; !ok {
+			history[videoID] = c.now()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return c.updateChannelProgress([]string{videoID}, true, history)
+}
+
+// WatchedChange records the watched state of videos before they were marked
+// or unmarked, so the change can be undone
+type WatchedChange struct {
+	IDs      []string
+	Previous map[string]time.Time // When each affected video was watched before, missing if it wasn't
+}
+
+// SetVideosWatched marks or unmarks videos as watched in a single write and
+// returns their previous state for UndoWatchedChange
+func (c *Client) SetVideosWatched(videoIDs []string, watched bool) (WatchedChange, error) {
+	change := WatchedChange{IDs: videoIDs, Previous: make(map[string]time.Time)}
+	now := c.now()
+	history, err := c.modifyWatchHistory(func(history map[string]time.Time) {
+		for _, id := range videoIDs {
+			watchedAt, wasWatched := history[id]
+			if wasWatched {
+				change.Previous[id] = watchedAt
+			}
+			if !watched {
+				delete(history, id)
+			} else if !wasWatched {
+				history[id] = now
+			}
+		}
+	})
+	if err != nil {
+		return change, err
+	}
+	return change, c.updateChannelProgress(videoIDs, watched, history)
+}
+
+// UndoWatchedChange restores the videos of a change to their previous watched state
+func (c *Client) UndoWatchedChange(change WatchedChange) error {
+	var rewatched, unwatched []string
+	history, err := c.modifyWatchHistory(func(history map[string]time.Time) {
+		for _, id := range change.IDs {
+			if watchedAt, ok := change.Previous[id]; ok {
+				history[id] = watchedAt
+				rewatched = append(rewatched, id)
+			} else {
+				delete(history, id)
+				unwatched = append(unwatched, id)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.updateChannelProgress(rewatched, true, history); err != nil {
+		return err
+	}
+	return c.updateChannelProgress(unwatched, false, history)
+}
+
+// SetWatchedRetentionDays sets for how many days watched videos are
+// remembered, so watched.json doesn't grow forever. 0 keeps them all.
+func (c *Client) SetWatchedRetentionDays(days int) {
+	c.watchedMu.Lock()
+	defer c.watchedMu.Unlock()
+	c.watchedRetentionDays = days
+}
+
+// GetWatchedVideos returns a map of video IDs that have been watched
+func (c *Client) GetWatchedVideos() (map[string]bool, error) {
+	history, err := c.GetWatchHistory()
+	watchedVideos := make(map[string]bool, len(history))
+	for id := range history {
+		watchedVideos[id] = true
+	}
+	return watchedVideos, err
+}
+
+// GetWatchHistory returns when each watched video was marked as watched.
+// Videos marked before timestamps were recorded have a zero time. The file
+// is read once and kept in memory; the map returned is a copy the caller
+// may change.
+func (c *Client) GetWatchHistory() (map[string]time.Time, error) {
+	c.watchedMu.Lock()
+	defer c.watchedMu.Unlock()
+
+	if err := c.readWatchHistory(); err != nil {
+		return make(map[string]time.Time), err
+	}
+	return copyWatchHistory(c.watchHistory), nil
+}
+
+// modifyWatchHistory applies change to the watch history and saves it,
+// holding watchedMu from reading it to writing it back so concurrent
+// updates, such as a player exiting while w is pressed, can't drop each
+// other's changes. It returns a copy of the saved history.
+func (c *Client) modifyWatchHistory(change func(history map[string]time.Time)) (map[string]time.Time, error) {
+	c.watchedMu.Lock()
+	defer c.watchedMu.Unlock()
+
+	if err := c.readWatchHistory(); err != nil {
+		return nil, err
+	}
+
+	// Change a copy, so a failed save leaves the history as it was
+	history := copyWatchHistory(c.watchHistory)
+	change(history)
+	if err := c.saveWatchHistory(history); err != nil {
+		return nil, err
+	}
+	return copyWatchHistory(c.watchHistory), nil
+}
+
+// readWatchHistory loads the watch history into memory if it isn't yet and
+// forgets what's past the retention period. The caller holds watchedMu.
+func (c *Client) readWatchHistory() error {
+	if c.watchHistory == nil {
+		history, err := c.loadWatchHistory()
+		if err != nil {
+			// Try reading it again next time
+			return err
+		}
+		c.watchHistory = history
+	}
+	c.pruneWatchHistory(c.watchHistory)
+	return nil
+}
+
+// copyWatchHistory returns a copy of the history the caller may change
+func copyWatchHistory(history map[string]time.Time) map[string]time.Time {
+	copied := make(map[string]time.Time, len(history))
+	for id, watchedAt := range history {
+		copied[id] = watchedAt
+	}
+	return copied
+}
+
+// loadWatchHistory reads the watched videos file
+func (c *Client) loadWatchHistory() (map[string]time.Time, error) {
+	history := make(map[string]time.Time)
+
+	// Get the watched videos file path
+	watchedPath, err := c.getWatchedVideosPath()
+	if err != nil {
+		return history, err
+	}
+
+	// Read the file, a missing file just means nothing has been watched yet
+	data, err := os.ReadFile(watchedPath)
+	if os.IsNotExist(err) {
+		return history, nil
+	}
+	if err != nil {
+		return history, err
+	}
+	if len(data) == 0 {
+		return history, nil
+	}
+
+	// Entries are either timestamps or, in older files, plain booleans
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return history, fmt.Errorf("error parsing watched videos: %w", err)
+	}
+	for id, raw := range entries {
+		var watchedAt time.Time
+		if err := json.Unmarshal(raw, &watchedAt); err == nil {
+			history[id] = watchedAt
+			continue
+		}
+		var watched bool
+		if err := json.Unmarshal(raw, &watched); err == nil && watched {
+			history[id] = time.Time{}
+		}
+	}
+
+	return history, nil
+}
+
+// pruneWatchHistory forgets the videos watched longer ago than the
+// retention period. Videos marked before timestamps were recorded are kept,
+// since how long ago they were watched isn't known.
+func (c *Client) pruneWatchHistory(history map[string]time.Time) {
+	if c.watchedRetentionDays <= 0 {
+		return
+	}
+	cutoff := c.now().AddDate(0, 0, -c.watchedRetentionDays)
+	for id, watchedAt := range history {
+		if !watchedAt.IsZero() && watchedAt.Before(cutoff) {
+			delete(history, id)
+		}
+	}
+}
+
+// saveWatchHistory saves the watched videos and their timestamps to a file,
+// leaving out the ones past the retention period. The caller holds watchedMu.
+func (c *Client) saveWatchHistory(history map[string]time.Time) error {
+	saved := make(map[string]time.Time, len(history))
+	for id, watchedAt := range history {
+		saved[id] = watchedAt
+	}
+	c.pruneWatchHistory(saved)
+
+	// Get the watched videos file path
+	watchedPath, err := c.getWatchedVideosPath()
+	if err != nil {
+		return err
+	}
+
+	// Ensure directory exists
+	dir := filepath.Dir(watchedPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	// Marshal to JSON
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Replace the file in one step so a crash can't leave it half written
+	if err := writeFileAtomic(watchedPath, data, 0644); err != nil {
+		return err
+	}
+	c.watchHistory = saved
+	return nil
+}
+
+// getWatchedVideosPath returns the path to the watched videos file
+func (c *Client) getWatchedVideosPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".config", "ytviewer", "watched.json"), nil
+}
+
+// IsVideoWatched checks if a video has been watched
+func (c *Client) IsVideoWatched(videoID string) (bool, error) {
+	watchedVideos, err := c.GetWatchedVideos()
+	if err != nil {
+		return false, err
+	}
+
+	return watchedVideos[videoID], nil
+}
+
+// ExportWatchHistory writes the watch history to a CSV file with the columns
+// video_id, title, channel and watched_at. Titles and channels come from the
+// video cache, so they are blank for videos that are no longer cached.
+func (c *Client) ExportWatchHistory(path string) error {
+	history, err := c.GetWatchHistory()
+	if err != nil {
+		return fmt.Errorf("error loading watch history: %w", err)
+	}
+
+	// Index the cached videos so they can be joined by ID
+	cached := make(map[string]Video)
+	for _, videos := range c.cachedVideos() {
+		for _, video := range videos {
+			cached[video.ID] = video
+		}
+	}
+
+	// Most recently watched first, undated entries last
+	ids := make([]string, 0, len(history))
+	for id := range history {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if !history[ids[i]].Equal(history[ids[j]]) {
+			return history[ids[i]].After(history[ids[j]])
+		}
+		return ids[i] < ids[j]
+	})
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating export file: %w", err)
+	}
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	w.Write([]string{"video_id", "title", "channel", "watched_at"})
+	for _, id := range ids {
+		video := cached[id]
+		watchedAt := ""
+		if !history[id].IsZero() {
+			watchedAt = history[id].Format(time.RFC3339)
+		}
+		w.Write([]string{id, video.Title, video.ChannelName, watchedAt})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("error writing export file: %w", err)
+	}
+
+	return file.Close()
+}
+
+// takeoutEntry is a single item of a Google Takeout watch-history.json
+type takeoutEntry struct {
+	Title    string `json:"title"`
+	TitleURL string `json:"titleUrl"`
+	Time     string `json:"time"`
+}
+
+// ImportWatchHistory merges a Google Takeout watch-history.json into the
+// watched store and returns how many videos were newly marked as watched.
+// Entries watched before since are skipped, a zero since imports everything.
+func (c *Client) ImportWatchHistory(path string, since time.Time) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("error reading watch history: %w", err)
+	}
+
+	var entries []takeoutEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return 0, fmt.Errorf("error parsing watch history: %w", err)
+	}
+
+	imported := 0
+	_, err = c.modifyWatchHistory(func(history map[string]time.Time) {
+		for _, entry := range entries {
+			// Removed videos and ads have no usable video URL
+			videoID, err := ParseVideoID(entry.TitleURL)
+			if err != nil {
+				continue
+			}
+
+			watchedAt, err := time.Parse(time.RFC3339, entry.Time)
+			if err != nil || watchedAt.Before(since) {
+				continue
+			}
+
+			// Videos watched several times keep the most recent watch, and
+			// undated entries from older stores get a timestamp
+			current, ok := history[videoID]
+			if !ok {
+				imported++
+			}
+			if !ok || watchedAt.After(current) {
+				history[videoID] = watchedAt
+			}
+		}
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error updating watch history: %w", err)
+	}
+	return imported, nil
+}
@@ -0,0 +1,51 @@
+package youtube
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentWatchedUpdatesAreKept(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	c := &Client{clock: RealClock{}}
+
+	const updates = 20
+	var wg sync.WaitGroup
+	for i := 0; i < updates; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprintf("video%02d", i)
+			var err error
+			if i%2 == 0 {
+				err = c.MarkVideoAsWatched(id)
+			} else {
+				_, err = c.SetVideosWatched([]string{id}, true)
+			}
+			if err != nil {
+				t.Errorf("marking %s: %v", id, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// Both the history in memory and the file must have every update
+	inMemory, err := c.GetWatchHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	onDisk, err := c.loadWatchHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < updates; i++ {
+		id := fmt.Sprintf("video%02d", i)
+		if _, ok := inMemory[id]; !ok {
+			t.Errorf("%s missing from the history in memory", id)
+		}
+		if _, ok := onDisk[id]; !ok {
+			t.Errorf("%s missing from watched.json", id)
+		}
+	}
+}
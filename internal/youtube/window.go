@@ -0,0 +1,30 @@
+package youtube
+
+// SetFullscreen sets whether MPV opens fullscreen
+func (c *Client) SetFullscreen(fullscreen bool) {
+	c.fullscreen = fullscreen
+}
+
+// Fullscreen reports whether MPV opens fullscreen
+func (c *Client) Fullscreen() bool {
+	return c.fullscreen
+}
+
+// SetGeometry sets the window size and position MPV opens with, in MPV's
+// --geometry syntax, e.g. "1280x720+1920+0". Empty leaves it to MPV.
+func (c *Client) SetGeometry(geometry string) {
+	c.geometry = geometry
+}
+
+// windowMPVArgs returns the MPV arguments for the window size, position and
+// fullscreen. The geometry still picks the monitor when fullscreen.
+func (c *Client) windowMPVArgs() []string {
+	var args []string
+	if c.geometry != "" {
+		args = append(args, "--geometry="+c.geometry)
+	}
+	if c.fullscreen {
+		args = append(args, "--fullscreen")
+	}
+	return args
+}